@@ -0,0 +1,459 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const esPath = "github.com/Trendyol/es-query-builder/es"
+
+// field is a single entry of a generated fields struct: a leaf field with its
+// value type and multi-fields, or a group of the fields of a struct.
+type field struct {
+	name      string
+	path      string
+	valueType string
+	subfields []string
+	children  []*field
+	group     bool
+	nested    bool
+}
+
+type typeDecl struct {
+	structType *ast.StructType
+	file       *ast.File
+}
+
+type generator struct {
+	fset    *token.FileSet
+	types   map[string]typeDecl
+	imports map[string]string
+	stack   []string
+}
+
+// Generate reads the Go package in dir and returns the source of a file that
+// declares a <Type>Fields variable for each of the given struct types.
+// The file named skip, usually the previously generated output, is not read.
+func Generate(dir string, typeNames []string, skip string) ([]byte, error) {
+	g := &generator{fset: token.NewFileSet(), types: map[string]typeDecl{}, imports: map[string]string{}}
+	packageName, err := g.parsePackage(dir, skip)
+	if err != nil {
+		return nil, err
+	}
+	roots := make([]*field, 0, len(typeNames))
+	for _, typeName := range typeNames {
+		typeName = strings.TrimSpace(typeName)
+		decl, ok := g.types[typeName]
+		if !ok {
+			return nil, fmt.Errorf("struct type %s not found in %s", typeName, dir)
+		}
+		g.stack = []string{typeName}
+		children, err := g.fields(decl.structType, decl.file, typeName, "")
+		if err != nil {
+			return nil, err
+		}
+		root := &field{name: typeName, children: children, group: true}
+		if err := checkNames(root); err != nil {
+			return nil, err
+		}
+		roots = append(roots, root)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by es-field-gen. DO NOT EDIT.\n\npackage %s\n\n", packageName)
+	buf.WriteString(g.importDecl())
+	for _, root := range roots {
+		typeName := lowerFirst(root.name) + "Fields"
+		fmt.Fprintf(&buf, "\n// %sFields holds typed references to the fields of %s documents.\n", root.name, root.name)
+		fmt.Fprintf(&buf, "var %sFields = %s\n", root.name, literal(root, typeName))
+		declare(&buf, root, typeName)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated source: %w", err)
+	}
+	return src, nil
+}
+
+// parsePackage indexes the struct types of the non-test files in dir and
+// returns the package name.
+func (g *generator) parsePackage(dir, skip string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	packageName := ""
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == skip {
+			continue
+		}
+		file, err := parser.ParseFile(g.fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", err
+		}
+		if packageName == "" {
+			packageName = file.Name.Name
+		}
+		if file.Name.Name != packageName {
+			continue
+		}
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if structType, isStruct := typeSpec.Type.(*ast.StructType); isStruct && typeSpec.TypeParams == nil {
+					g.types[typeSpec.Name.Name] = typeDecl{structType: structType, file: file}
+				}
+			}
+		}
+	}
+	if packageName == "" {
+		return "", fmt.Errorf("no Go files in %s", dir)
+	}
+	return packageName, nil
+}
+
+// fields returns the fields of a struct type, with paths under prefix.
+func (g *generator) fields(structType *ast.StructType, file *ast.File, owner, prefix string) ([]*field, error) {
+	var fields []*field
+	for _, astField := range structType.Fields.List {
+		tag := reflect.StructTag("")
+		if astField.Tag != nil {
+			unquoted, _ := strconv.Unquote(astField.Tag.Value)
+			tag = reflect.StructTag(unquoted)
+		}
+		jsonName, _, _ := strings.Cut(tag.Get("json"), ",")
+		if jsonName == "-" && !strings.HasPrefix(tag.Get("json"), "-,") || tag.Get("es") == "-" {
+			continue
+		}
+		if len(astField.Names) == 0 {
+			embedded, err := g.embedded(astField, file, owner, jsonName, prefix)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, embedded...)
+			continue
+		}
+		for _, name := range astField.Names {
+			if !name.IsExported() {
+				continue
+			}
+			path := jsonName
+			if path == "" {
+				path = name.Name
+			}
+			f, err := g.field(astField.Type, file, name.Name, prefix+path)
+			if err != nil {
+				return nil, err
+			}
+			if f == nil {
+				continue
+			}
+			if err := applyOptions(f, tag.Get("es")); err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", owner, name.Name, err)
+			}
+			fields = append(fields, f)
+		}
+	}
+	return fields, nil
+}
+
+// embedded returns the fields of an embedded struct, which encoding/json
+// promotes to the embedding struct unless the embedded field has a json name.
+// Like encoding/json, it keeps embedded structs of unexported types, since
+// their exported fields are still promoted.
+func (g *generator) embedded(astField *ast.Field, file *ast.File, owner, jsonName, prefix string) ([]*field, error) {
+	ident, ok := unwrapPointer(astField.Type).(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	if _, isStruct := g.types[ident.Name]; !isStruct && !ident.IsExported() {
+		return nil, nil
+	}
+	if jsonName != "" {
+		f, err := g.field(astField.Type, file, ident.Name, prefix+jsonName)
+		if err != nil || f == nil {
+			return nil, err
+		}
+		return []*field{f}, nil
+	}
+	decl, isStruct := g.types[ident.Name]
+	if !isStruct || g.visiting(ident.Name) {
+		return nil, nil
+	}
+	g.stack = append(g.stack, ident.Name)
+	defer func() { g.stack = g.stack[:len(g.stack)-1] }()
+	return g.fields(decl.structType, decl.file, owner, prefix)
+}
+
+// field resolves the Go type of a struct field to a group of fields or a leaf
+// field. Recursive types and types JSON cannot encode return nil.
+func (g *generator) field(expr ast.Expr, file *ast.File, name, path string) (*field, error) {
+	expr = unwrapValue(expr)
+	switch t := expr.(type) {
+	case *ast.StructType:
+		children, err := g.fields(t, file, name, path+".")
+		if err != nil {
+			return nil, err
+		}
+		return &field{name: name, path: path, children: children, group: true}, nil
+	case *ast.Ident:
+		if decl, isStruct := g.types[t.Name]; isStruct {
+			if g.visiting(t.Name) {
+				return nil, nil
+			}
+			g.stack = append(g.stack, t.Name)
+			defer func() { g.stack = g.stack[:len(g.stack)-1] }()
+			children, err := g.fields(decl.structType, decl.file, t.Name, path+".")
+			if err != nil {
+				return nil, err
+			}
+			return &field{name: name, path: path, children: children, group: true}, nil
+		}
+	case *ast.FuncType, *ast.ChanType:
+		return nil, nil
+	}
+	return &field{name: name, path: path, valueType: g.typeString(expr, file)}, nil
+}
+
+func (g *generator) visiting(typeName string) bool {
+	for _, name := range g.stack {
+		if name == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+// typeString prints a type expression and records the imports it refers to.
+func (g *generator) typeString(expr ast.Expr, file *ast.File) string {
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if pkg, isIdent := selector.X.(*ast.Ident); isIdent {
+				g.use(pkg.Name, file)
+			}
+		}
+		return true
+	})
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, g.fset, expr)
+	return buf.String()
+}
+
+// use records the import of file whose package name is name.
+func (g *generator) use(name string, file *ast.File) {
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		alias := ""
+		if spec.Name != nil {
+			alias = spec.Name.Name
+		}
+		if alias == name || alias == "" && path[strings.LastIndex(path, "/")+1:] == name {
+			g.imports[path] = alias
+			return
+		}
+	}
+}
+
+func (g *generator) importDecl() string {
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	var buf strings.Builder
+	buf.WriteString("import (\n")
+	for _, path := range paths {
+		if alias := g.imports[path]; alias != "" {
+			fmt.Fprintf(&buf, "\t%s %q\n", alias, path)
+		} else {
+			fmt.Fprintf(&buf, "\t%q\n", path)
+		}
+	}
+	if len(paths) > 0 {
+		buf.WriteString("\n")
+	}
+	fmt.Fprintf(&buf, "\t%q\n)\n", esPath)
+	return buf.String()
+}
+
+// applyOptions applies the options of an es struct tag to a field.
+func applyOptions(f *field, options string) error {
+	if options == "" {
+		return nil
+	}
+	for _, option := range strings.Split(options, ",") {
+		option = strings.TrimSpace(option)
+		subfield := ""
+		switch {
+		case option == "nested":
+			if !f.group {
+				return errors.New("es tag option nested on a field that is not a struct")
+			}
+			f.nested = true
+		case option == "keyword":
+			subfield = "keyword"
+		case strings.HasPrefix(option, "subfield="):
+			subfield = strings.TrimPrefix(option, "subfield=")
+		default:
+			return fmt.Errorf("unknown es tag option %q", option)
+		}
+		if subfield == "" {
+			continue
+		}
+		if f.group {
+			return fmt.Errorf("es tag option %q on a struct field", option)
+		}
+		if !token.IsIdentifier(upperCamel(subfield)) {
+			return fmt.Errorf("invalid subfield name %q", subfield)
+		}
+		f.subfields = append(f.subfields, subfield)
+	}
+	return nil
+}
+
+// checkNames reports fields whose Go name collides with the path embedded in
+// the generated struct of their group.
+func checkNames(f *field) error {
+	for _, child := range f.children {
+		if f.path != "" && child.name == embeddedName(f) {
+			return fmt.Errorf("field %s of %s conflicts with the embedded es.%s", child.name, f.path, embeddedName(f))
+		}
+		if err := checkNames(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// embeddedName returns the name of the field embedded in the generated struct
+// of a group or a leaf with multi-fields.
+func embeddedName(f *field) string {
+	switch {
+	case f.nested:
+		return "NestedPath"
+	case f.group:
+		return "Path"
+	default:
+		return "Field"
+	}
+}
+
+func literal(f *field, typeName string) string {
+	if !f.group && len(f.subfields) == 0 {
+		return strconv.Quote(f.path)
+	}
+	var buf strings.Builder
+	buf.WriteString(typeName + "{\n")
+	if f.path != "" {
+		fmt.Fprintf(&buf, "%s: %q,\n", embeddedName(f), f.path)
+	}
+	for _, subfield := range f.subfields {
+		fmt.Fprintf(&buf, "%s: %q,\n", upperCamel(subfield), f.path+"."+subfield)
+	}
+	for _, child := range f.children {
+		fmt.Fprintf(&buf, "%s: %s,\n", child.name, literal(child, childTypeName(typeName, child)))
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+func declare(buf *bytes.Buffer, f *field, typeName string) {
+	fmt.Fprintf(buf, "\ntype %s struct {\n", typeName)
+	switch {
+	case f.path == "":
+	case f.group:
+		fmt.Fprintf(buf, "es.%s\n", embeddedName(f))
+	default:
+		fmt.Fprintf(buf, "es.Field[%s]\n", f.valueType)
+	}
+	for _, subfield := range f.subfields {
+		fmt.Fprintf(buf, "%s es.Field[%s]\n", upperCamel(subfield), f.valueType)
+	}
+	var nested []*field
+	for _, child := range f.children {
+		if child.group || len(child.subfields) > 0 {
+			fmt.Fprintf(buf, "%s %s\n", child.name, childTypeName(typeName, child))
+			nested = append(nested, child)
+		} else {
+			fmt.Fprintf(buf, "%s es.Field[%s]\n", child.name, child.valueType)
+		}
+	}
+	buf.WriteString("}\n")
+	for _, child := range nested {
+		declare(buf, child, childTypeName(typeName, child))
+	}
+}
+
+func childTypeName(parent string, child *field) string {
+	return strings.TrimSuffix(parent, "Fields") + child.name + "Fields"
+}
+
+// unwrapValue strips pointers and slices, whose elements are indexed as
+// values of the field, but keeps []byte, which encodes as a string.
+func unwrapValue(expr ast.Expr) ast.Expr {
+	for {
+		switch t := expr.(type) {
+		case *ast.StarExpr:
+			expr = t.X
+		case *ast.ArrayType:
+			if ident, ok := t.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+				return expr
+			}
+			expr = t.Elt
+		case *ast.ParenExpr:
+			expr = t.X
+		default:
+			return expr
+		}
+	}
+}
+
+func unwrapPointer(expr ast.Expr) ast.Expr {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		return star.X
+	}
+	return expr
+}
+
+func lowerFirst(s string) string {
+	runes := []rune(s)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// upperCamel turns a multi-field name such as "search_as_you_type" into a Go
+// field name such as "SearchAsYouType".
+func upperCamel(s string) string {
+	var buf strings.Builder
+	upper := true
+	for _, r := range s {
+		if r == '_' || r == '-' || r == '.' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+// nolint:golint,lll
+const productSource = `package catalog
+
+import (
+	"time"
+
+	decimal "example.com/money"
+)
+
+type Status string
+
+type Audit struct {
+	CreatedAt time.Time ` + "`json:\"created_at\"`" + `
+	UpdatedBy string
+}
+
+type Variant struct {
+	Color string   ` + "`json:\"color\" es:\"keyword\"`" + `
+	Price *float64 ` + "`json:\"price\"`" + `
+}
+
+type Category struct {
+	Name     string     ` + "`json:\"name\"`" + `
+	Children []Category ` + "`json:\"children\"`" + `
+}
+
+type Product struct {
+	Audit
+	ID        string            ` + "`json:\"id\"`" + `
+	Title     string            ` + "`json:\"title,omitempty\" es:\"keyword,subfield=search_as_you_type\"`" + `
+	Status    Status            ` + "`json:\"status\"`" + `
+	Tags      []string          ` + "`json:\"tags\"`" + `
+	Price     decimal.Amount    ` + "`json:\"price\"`" + `
+	Variants  []Variant         ` + "`json:\"variants\" es:\"nested\"`" + `
+	Category  *Category         ` + "`json:\"category\"`" + `
+	Seller    struct{ ID int64 ` + "`json:\"id\"`" + ` } ` + "`json:\"seller\"`" + `
+	Internal  string            ` + "`json:\"-\"`" + `
+	Unindexed string            ` + "`json:\"unindexed\" es:\"-\"`" + `
+	OnChange  func()            ` + "`json:\"on_change\"`" + `
+	secret    string
+}
+`
+
+// nolint:golint,lll
+const productFields = `// Code generated by es-field-gen. DO NOT EDIT.
+
+package catalog
+
+import (
+	decimal "example.com/money"
+	"time"
+
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+// ProductFields holds typed references to the fields of Product documents.
+var ProductFields = productFields{
+	CreatedAt: "created_at",
+	UpdatedBy: "UpdatedBy",
+	ID:        "id",
+	Title: productTitleFields{
+		Field:           "title",
+		Keyword:         "title.keyword",
+		SearchAsYouType: "title.search_as_you_type",
+	},
+	Status: "status",
+	Tags:   "tags",
+	Price:  "price",
+	Variants: productVariantsFields{
+		NestedPath: "variants",
+		Color: productVariantsColorFields{
+			Field:   "variants.color",
+			Keyword: "variants.color.keyword",
+		},
+		Price: "variants.price",
+	},
+	Category: productCategoryFields{
+		Path: "category",
+		Name: "category.name",
+	},
+	Seller: productSellerFields{
+		Path: "seller",
+		ID:   "seller.id",
+	},
+}
+
+type productFields struct {
+	CreatedAt es.Field[time.Time]
+	UpdatedBy es.Field[string]
+	ID        es.Field[string]
+	Title     productTitleFields
+	Status    es.Field[Status]
+	Tags      es.Field[string]
+	Price     es.Field[decimal.Amount]
+	Variants  productVariantsFields
+	Category  productCategoryFields
+	Seller    productSellerFields
+}
+
+type productTitleFields struct {
+	es.Field[string]
+	Keyword         es.Field[string]
+	SearchAsYouType es.Field[string]
+}
+
+type productVariantsFields struct {
+	es.NestedPath
+	Color productVariantsColorFields
+	Price es.Field[float64]
+}
+
+type productVariantsColorFields struct {
+	es.Field[string]
+	Keyword es.Field[string]
+}
+
+type productCategoryFields struct {
+	es.Path
+	Name es.Field[string]
+}
+
+type productSellerFields struct {
+	es.Path
+	ID es.Field[int64]
+}
+`
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func Test_Generate_should_render_typed_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "product.go"), productSource)
+	writeFile(t, filepath.Join(dir, "product_fields.go"), "package catalog\n\nvar stale = 1\n")
+	writeFile(t, filepath.Join(dir, "product_test.go"), "package catalog_test\n")
+
+	// When
+	src, err := Generate(dir, []string{"Product"}, "product_fields.go")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, productFields, string(src))
+}
+
+func Test_Generate_should_return_error_for_invalid_input(t *testing.T) {
+	t.Parallel()
+	// Given
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "doc.go"), `package doc
+
+type Status string
+
+type Doc struct {
+	Name    string `+"`es:\"nested\"`"+`
+	Tags    string `+"`es:\"analyzed\"`"+`
+}
+
+type Group struct {
+	Inner struct{ Path string } `+"`json:\"inner\"`"+`
+}
+`)
+
+	// When
+	_, missingErr := Generate(dir, []string{"Missing"}, "")
+	_, notStructErr := Generate(dir, []string{"Status"}, "")
+	_, optionErr := Generate(dir, []string{"Doc"}, "")
+	_, conflictErr := Generate(dir, []string{"Group"}, "")
+	_, emptyErr := Generate(t.TempDir(), []string{"Doc"}, "")
+
+	// Then
+	assert.Equal(t, "struct type Missing not found in "+dir, missingErr.Error())
+	assert.Equal(t, "struct type Status not found in "+dir, notStructErr.Error())
+	assert.Equal(t, "Doc.Name: es tag option nested on a field that is not a struct", optionErr.Error())
+	assert.Equal(t, "field Path of inner conflicts with the embedded es.Path", conflictErr.Error())
+	assert.True(t, strings.HasPrefix(emptyErr.Error(), "no Go files in "))
+}
+
+func Test_Generate_should_promote_fields_of_unexported_embedded_structs(t *testing.T) {
+	t.Parallel()
+	// Given
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "doc.go"), `package doc
+
+type base struct{ ID string `+"`json:\"id\"`"+` }
+
+type hidden struct{ Secret string }
+
+type weight float64
+
+type Doc struct {
+	base
+	hidden `+"`json:\"-\"`"+`
+	weight
+	Name string `+"`json:\"name\"`"+`
+}
+`)
+
+	// When
+	src, err := Generate(dir, []string{"Doc"}, "")
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(string(src), "var DocFields = docFields{\n\tID:   \"id\",\n\tName: \"name\",\n}"))
+}
+
+func Test_run_should_write_output_file(t *testing.T) {
+	t.Parallel()
+	// Given
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "doc.go"), "package doc\n\ntype Doc struct {\n\tName string `json:\"name\"`\n}\n")
+
+	// When
+	err := run(dir, "Doc", "")
+	missingTypeErr := run(dir, "", "")
+
+	// Then
+	assert.Nil(t, err)
+	src, readErr := os.ReadFile(filepath.Join(dir, "doc_fields.go"))
+	assert.Nil(t, readErr)
+	assert.True(t, strings.Contains(string(src), "var DocFields = docFields{\n\tName: \"name\",\n}"))
+	assert.Equal(t, "-type is required", missingTypeErr.Error())
+}
+
+func Test_Generate_should_compile_against_es_package(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles the generated code")
+	}
+	// Given
+	root, err := filepath.Abs("../..")
+	assert.Nil(t, err)
+	dir := t.TempDir()
+	goMod := "module catalog\n\ngo 1.18\n\nrequire github.com/Trendyol/es-query-builder v0.0.0\n\n" +
+		"replace github.com/Trendyol/es-query-builder => " + root + "\n"
+	writeFile(t, filepath.Join(dir, "go.mod"), goMod)
+	writeFile(t, filepath.Join(dir, "product.go"), `package main
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type Status string
+
+type Variant struct {
+	Color string `+"`json:\"color\"`"+`
+}
+
+type Product struct {
+	Date     time.Time `+"`json:\"date\"`"+`
+	Title    string    `+"`json:\"title\" es:\"keyword\"`"+`
+	Status   Status    `+"`json:\"status\"`"+`
+	Variants []Variant `+"`json:\"variants\" es:\"nested\"`"+`
+}
+
+func main() {
+	query := es.NewQuery(es.Bool().Filter(
+		es.TermField(ProductFields.Title.Keyword, "phone"),
+		es.TermsField(ProductFields.Status, "active"),
+		es.NestedField(ProductFields.Variants.NestedPath, es.TermField(ProductFields.Variants.Color, "red")),
+	)).Sort(es.Sort(ProductFields.Date.Name()))
+	if err := json.NewEncoder(os.Stdout).Encode(query); err != nil {
+		panic(err)
+	}
+}
+`)
+	assert.Nil(t, run(dir, "Product", ""))
+
+	// When
+	cmd := exec.Command("go", "run", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+	output, err := cmd.CombinedOutput()
+
+	// Then
+	assert.Nil(t, err, string(output))
+	// nolint:golint,lll
+	assert.Equal(t,
+		`{"query":{"bool":{"filter":[{"term":{"title.keyword":{"value":"phone"}}},{"terms":{"status":["active"]}},{"nested":{"path":"variants","query":{"term":{"variants.color":{"value":"red"}}}}}]}},"sort":[{"date":{}}]}`,
+		strings.TrimSpace(string(output)),
+	)
+}
@@ -0,0 +1,70 @@
+// Command es-field-gen generates typed field references for document structs,
+// so queries refer to fields through compiler-checked names instead of raw
+// strings.
+//
+// Usage:
+//
+//	es-field-gen -type Product[,Order...] [-output file] [dir]
+//
+// The structs are read from the Go package in dir, the current directory by
+// default, and a ProductFields variable is written for each type to
+// <type>_fields.go, or to the file given with -output. It is meant to be run
+// through go generate:
+//
+//	//go:generate go run github.com/Trendyol/es-query-builder/cmd/es-field-gen -type Product
+//
+// Field paths follow the json tags of the struct fields. The es tag adds what
+// the JSON encoding does not know about:
+//
+//	Title    string    `json:"title" es:"keyword"`          // title and title.keyword
+//	Name     string    `json:"name" es:"subfield=raw"`      // name and name.raw
+//	Variants []Variant `json:"variants" es:"nested"`        // nested path
+//	Internal string    `json:"internal" es:"-"`             // not indexed
+//
+// Fields of struct types become groups whose fields carry the full dotted
+// path, and the value type of each field is the Go type of the struct field,
+// with pointers and slices unwrapped, so the typed helpers such as
+// es.TermField only accept values of that type:
+//
+//	es.TermField(ProductFields.Title.Keyword, "phone")
+//	es.NestedField(ProductFields.Variants.NestedPath, es.TermField(ProductFields.Variants.Color, "red"))
+//	es.Sort(ProductFields.Date.Name())
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeNames := flag.String("type", "", "comma-separated list of struct type names; required")
+	output := flag.String("output", "", "output file name; default <dir>/<type>_fields.go")
+	flag.Parse()
+
+	if err := run(flag.Arg(0), *typeNames, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "es-field-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, typeNames, output string) error {
+	if typeNames == "" {
+		return errors.New("-type is required")
+	}
+	if dir == "" {
+		dir = "."
+	}
+	types := strings.Split(typeNames, ",")
+	if output == "" {
+		output = filepath.Join(dir, strings.ToLower(types[0])+"_fields.go")
+	}
+	src, err := Generate(dir, types, filepath.Base(output))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(output, src, 0o644) // nolint:gosec // generated source is checked in
+}
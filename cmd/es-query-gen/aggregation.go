@@ -0,0 +1,377 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// aggregationGenerator describes how to render one aggregation type and which
+// of the shared "aggs" and "meta" keys its builder supports.
+type aggregationGenerator struct {
+	generate clauseGenerator
+	aggs     bool
+	meta     bool
+}
+
+// aggregationGenerators is populated in init because the generators call
+// back into generator.aggs, which reads this map.
+var aggregationGenerators map[string]aggregationGenerator
+
+var (
+	missingOption       = option{key: "missing", method: "Missing", kind: anyArg}
+	formatOption        = option{key: "format", method: "Format", kind: stringArg}
+	sizeOption          = option{key: "size", method: "Size", kind: intArg}
+	shardSizeOption     = option{key: "shard_size", method: "ShardSize", kind: floatArg}
+	minDocCountOption   = option{key: "min_doc_count", method: "MinDocCount", kind: intArg}
+	keyedOption         = option{key: "keyed", method: "Keyed", kind: boolArg}
+	timeZoneOption      = option{key: "time_zone", method: "TimeZone", kind: stringArg}
+	includeOption       = option{key: "include", method: "Include", kind: variadicStringsArg}
+	excludeOption       = option{key: "exclude", method: "Exclude", kind: variadicStringsArg}
+	executionHintOption = option{key: "execution_hint", method: "ExecutionHint", kind: enumArg, enum: &executionHintEnum}
+	collectModeOption   = option{key: "collect_mode", method: "CollectMode", kind: enumArg, enum: &collectModeEnum}
+)
+
+func init() {
+	metric := func(constructor string, options ...option) aggregationGenerator {
+		return aggregationGenerator{generate: fieldAggregation(constructor, options...), aggs: true, meta: true}
+	}
+	aggregationGenerators = map[string]aggregationGenerator{
+		"avg":            metric("es.AvgAgg", missingOption, formatOption),
+		"min":            metric("es.MinAgg", missingOption, formatOption),
+		"max":            metric("es.MaxAgg", missingOption, formatOption),
+		"sum":            metric("es.SumAgg", missingOption, formatOption),
+		"stats":          metric("es.StatsAgg", missingOption, formatOption),
+		"extended_stats": metric("es.ExtendedStatsAgg", missingOption, formatOption),
+		"value_count":    metric("es.ValueCountAgg", missingOption),
+		"cardinality": metric("es.CardinalityAgg", missingOption,
+			option{key: "precision_threshold", method: "PrecisionThreshold", kind: intArg},
+		),
+		"terms":          {generate: termsAggregation, aggs: true, meta: true},
+		"multi_terms":    {generate: multiTermsAggregation, aggs: true, meta: true},
+		"histogram":      {generate: histogramAggregation, aggs: true, meta: true},
+		"date_histogram": {generate: dateHistogramAggregation, aggs: true, meta: true},
+		"range":          {generate: rangeAggregation("es.RangeAgg", "es.RangeEntry()", keyedOption, missingOption), aggs: true, meta: true},
+		"date_range": {
+			generate: rangeAggregation("es.DateRangeAgg", "es.DateRangeEntry()", formatOption, keyedOption, missingOption, timeZoneOption),
+			aggs:     true, meta: true,
+		},
+		"filter":         {generate: filterAggregation, aggs: true, meta: true},
+		"filters":        {generate: filtersAggregation, aggs: true, meta: true},
+		"nested":         {generate: nestedAggregation, aggs: true},
+		"reverse_nested": {generate: reverseNestedAggregation, aggs: true, meta: true},
+		"top_hits":       {generate: topHitsAggregation, meta: true},
+	}
+}
+
+// aggs renders named aggregations as es.Agg calls sorted by name.
+func (g *generator) aggs(aggs map[string]any) string {
+	items := make([]string, 0, len(aggs))
+	for _, name := range sortedKeys(aggs) {
+		items = append(items, "es.Agg("+strconv.Quote(name)+", "+g.aggregation(aggs[name])+")")
+	}
+	return strings.Join(items, ",\n")
+}
+
+// aggregation renders an aggregation with the builder when possible and as
+// an es.Object literal otherwise.
+func (g *generator) aggregation(value any) string {
+	expr, ok := g.try(func() (string, bool) {
+		body, ok := value.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		return g.typedAggregation(body)
+	})
+	if ok {
+		return expr
+	}
+	return g.literal(value)
+}
+
+func (g *generator) typedAggregation(body map[string]any) (string, bool) {
+	var name string
+	for key := range body {
+		if key == "aggs" || key == "meta" {
+			continue
+		}
+		if name != "" {
+			return "", false
+		}
+		name = key
+	}
+	generator, ok := aggregationGenerators[name]
+	if !ok {
+		return "", false
+	}
+	params, ok := body[name].(map[string]any)
+	if !ok {
+		return "", false
+	}
+	expr, ok := generator.generate(g, params)
+	if !ok {
+		return "", false
+	}
+	if value, exists := body["meta"]; exists {
+		meta, ok := value.(map[string]any)
+		if !ok || !generator.meta || len(meta) == 0 {
+			return "", false
+		}
+		for _, key := range sortedKeys(meta) {
+			expr += ".\nMeta(" + strconv.Quote(key) + ", " + g.literal(meta[key]) + ")"
+		}
+	}
+	if value, exists := body["aggs"]; exists {
+		aggs, ok := value.(map[string]any)
+		if !ok || !generator.aggs || len(aggs) == 0 {
+			return "", false
+		}
+		expr += ".\nAggs(" + g.aggs(aggs) + ")"
+	}
+	return expr, true
+}
+
+func fieldAggregation(constructor string, options ...option) clauseGenerator {
+	return func(g *generator, body map[string]any) (string, bool) {
+		field, ok := body["field"].(string)
+		if !ok {
+			return "", false
+		}
+		return g.chain(constructor+"("+strconv.Quote(field)+")", body, []string{"field"}, options...)
+	}
+}
+
+// withOrder appends an Order call for the "order" key, accepting both a
+// single {"key": "asc"} object and an array of them.
+func (g *generator) withOrder(expr string, body map[string]any) (string, bool) {
+	value, exists := body["order"]
+	if !exists {
+		return expr, true
+	}
+	entries, ok := value.([]any)
+	if !ok {
+		object, isObject := value.(map[string]any)
+		if !isObject {
+			return "", false
+		}
+		for _, key := range sortedKeys(object) {
+			entries = append(entries, map[string]any{key: object[key]})
+		}
+	}
+	if len(entries) == 0 {
+		return "", false
+	}
+	orders := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		object, ok := entry.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		key, direction, ok := singleEntry(object)
+		if !ok {
+			return "", false
+		}
+		order, ok := g.argument(enumArg, &orderEnum, direction)
+		if !ok {
+			return "", false
+		}
+		orders = append(orders, "es.AggOrder("+strconv.Quote(key)+", "+order+")")
+	}
+	return expr + ".\nOrder(" + strings.Join(orders, ", ") + ")", true
+}
+
+func termsAggregation(g *generator, body map[string]any) (string, bool) {
+	expr, ok := fieldAggregation("es.TermsAgg", sizeOption, shardSizeOption,
+		option{key: "show_term_doc_count_error", method: "ShowTermDocCountError", kind: boolArg},
+		minDocCountOption, missingOption, includeOption, excludeOption, executionHintOption, collectModeOption,
+	)(g, withoutOrder(body))
+	if !ok {
+		return "", false
+	}
+	return g.withOrder(expr, body)
+}
+
+func multiTermsAggregation(g *generator, body map[string]any) (string, bool) {
+	terms, ok := body["terms"].([]any)
+	if !ok || len(terms) == 0 {
+		return "", false
+	}
+	items := make([]string, 0, len(terms))
+	for _, term := range terms {
+		object, ok := term.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		field, value, ok := singleEntry(object)
+		name, isString := value.(string)
+		if !ok || field != "field" || !isString {
+			return "", false
+		}
+		items = append(items, "es.TermAgg("+strconv.Quote(name)+")")
+	}
+	expr, ok := g.chain("es.MultiTermsAgg("+strings.Join(items, ", ")+")", withoutOrder(body), []string{"terms"},
+		sizeOption, shardSizeOption, minDocCountOption, missingOption, includeOption, excludeOption,
+		executionHintOption, collectModeOption,
+	)
+	if !ok {
+		return "", false
+	}
+	return g.withOrder(expr, body)
+}
+
+func histogramAggregation(g *generator, body map[string]any) (string, bool) {
+	field, ok := body["field"].(string)
+	if !ok {
+		return "", false
+	}
+	interval, ok := g.argument(floatArg, nil, body["interval"])
+	if !ok {
+		return "", false
+	}
+	expr, ok := g.chain("es.HistogramAgg("+strconv.Quote(field)+", "+interval+")", withoutOrder(body),
+		[]string{"field", "interval", "extended_bounds", "hard_bounds"},
+		minDocCountOption, option{key: "offset", method: "Offset", kind: floatArg}, keyedOption, missingOption,
+	)
+	if !ok {
+		return "", false
+	}
+	if expr, ok = g.bounds(expr, body, floatArg); !ok {
+		return "", false
+	}
+	return g.withOrder(expr, body)
+}
+
+func dateHistogramAggregation(g *generator, body map[string]any) (string, bool) {
+	field, ok := body["field"].(string)
+	if !ok {
+		return "", false
+	}
+	expr, ok := g.chain("es.DateHistogramAgg("+strconv.Quote(field)+")", withoutOrder(body),
+		[]string{"field", "extended_bounds", "hard_bounds"},
+		option{key: "calendar_interval", method: "CalendarInterval", kind: stringArg},
+		option{key: "fixed_interval", method: "FixedInterval", kind: stringArg},
+		formatOption, timeZoneOption, option{key: "offset", method: "Offset", kind: stringArg},
+		minDocCountOption, keyedOption, missingOption,
+	)
+	if !ok {
+		return "", false
+	}
+	if expr, ok = g.bounds(expr, body, anyArg); !ok {
+		return "", false
+	}
+	return g.withOrder(expr, body)
+}
+
+// bounds appends ExtendedBounds and HardBounds calls whose min and max
+// arguments are rendered with the given kind.
+func (g *generator) bounds(expr string, body map[string]any, kind argKind) (string, bool) {
+	for _, key := range []string{"extended_bounds", "hard_bounds"} {
+		value, exists := body[key]
+		if !exists {
+			continue
+		}
+		bounds, ok := value.(map[string]any)
+		if !ok || len(bounds) != 2 {
+			return "", false
+		}
+		lower, minOk := g.argument(kind, nil, bounds["min"])
+		upper, maxOk := g.argument(kind, nil, bounds["max"])
+		if !minOk || !maxOk {
+			return "", false
+		}
+		method := map[string]string{"extended_bounds": "ExtendedBounds", "hard_bounds": "HardBounds"}[key]
+		expr += ".\n" + method + "(" + lower + ", " + upper + ")"
+	}
+	return expr, true
+}
+
+func rangeAggregation(constructor, entryConstructor string, options ...option) clauseGenerator {
+	return func(g *generator, body map[string]any) (string, bool) {
+		expr, ok := fieldAggregation(constructor, options...)(g, withoutKey(body, "ranges"))
+		if !ok {
+			return "", false
+		}
+		ranges, ok := body["ranges"].([]any)
+		if !ok {
+			return "", false
+		}
+		for _, value := range ranges {
+			entry, ok := value.(map[string]any)
+			if !ok {
+				return "", false
+			}
+			call, ok := g.chain(entryConstructor, entry, nil,
+				option{key: "from", method: "From", kind: anyArg},
+				option{key: "to", method: "To", kind: anyArg},
+				option{key: "key", method: "Key", kind: stringArg},
+			)
+			if !ok {
+				return "", false
+			}
+			expr += ".\nRange(" + call + ")"
+		}
+		return expr, true
+	}
+}
+
+func filterAggregation(g *generator, body map[string]any) (string, bool) {
+	return "es.FilterAgg(" + g.query(body) + ")", true
+}
+
+func filtersAggregation(g *generator, body map[string]any) (string, bool) {
+	filters, ok := body["filters"].(map[string]any)
+	if !ok {
+		return "", false
+	}
+	expr := "es.FiltersAgg()"
+	for _, name := range sortedKeys(filters) {
+		if _, isObject := filters[name].(map[string]any); !isObject {
+			return "", false
+		}
+		expr += ".\nFilter(" + strconv.Quote(name) + ", " + g.query(filters[name]) + ")"
+	}
+	return g.chain(expr, body, []string{"filters"},
+		option{key: "other_bucket", method: "OtherBucket", kind: boolArg},
+		option{key: "other_bucket_key", method: "OtherBucketKey", kind: stringArg},
+	)
+}
+
+func nestedAggregation(g *generator, body map[string]any) (string, bool) {
+	path, ok := body["path"].(string)
+	if !ok || len(body) != 1 {
+		return "", false
+	}
+	return "es.NestedAgg(" + strconv.Quote(path) + ")", true
+}
+
+func reverseNestedAggregation(g *generator, body map[string]any) (string, bool) {
+	return g.chain("es.ReverseNestedAgg()", body, nil, option{key: "path", method: "Path", kind: stringArg})
+}
+
+func topHitsAggregation(g *generator, body map[string]any) (string, bool) {
+	expr, ok := g.chain("es.TopHitsAgg()", body, []string{"sort", "_source"},
+		option{key: "from", method: "From", kind: intArg},
+		sizeOption,
+		option{key: "explain", method: "Explain", kind: boolArg},
+		option{key: "version", method: "Version", kind: boolArg},
+		option{key: "seq_no_primary_term", method: "SeqNoPrimaryTerm", kind: boolArg},
+		option{key: "track_scores", method: "TrackScores", kind: boolArg},
+	)
+	if !ok {
+		return "", false
+	}
+	return g.hitsOptions(expr, body)
+}
+
+func withoutOrder(body map[string]any) map[string]any {
+	return withoutKey(body, "order")
+}
+
+func withoutKey(body map[string]any, key string) map[string]any {
+	rest := make(map[string]any, len(body))
+	for k, v := range body {
+		if k != key {
+			rest[k] = v
+		}
+	}
+	return rest
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// generator turns a decoded search body into es-query-builder source code
+// and keeps track of the packages the generated code refers to.
+type generator struct {
+	imports map[string]string
+}
+
+// rootKeys lists the search body keys that are rendered with es.Object
+// methods, in the order the methods are chained.
+var rootKeys = []string{"query", "aggs", "size", "from", "track_total_hits", "_source", "sort", "post_filter", "search_after"}
+
+// Generate returns a gofmt'ed Go source file declaring a function named
+// funcName in package packageName that builds the given JSON search body.
+// Clauses and keys the builder does not cover are emitted as es.Object
+// literals so the generated code always marshals back to the same JSON.
+func Generate(data []byte, packageName, funcName string) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var body map[string]any
+	if err := decoder.Decode(&body); err != nil {
+		return nil, fmt.Errorf("invalid search body: %w", err)
+	}
+	if body == nil {
+		return nil, errors.New("invalid search body: expected a JSON object")
+	}
+
+	g := &generator{imports: map[string]string{}}
+	g.use(esPath, "")
+	expr, rest := g.root(body)
+
+	var src strings.Builder
+	src.WriteString("package " + packageName + "\n\n")
+	src.WriteString(g.importDecl())
+	src.WriteString("\nfunc " + funcName + "() es.Object {\n")
+	if len(rest) == 0 {
+		src.WriteString("return " + expr + "\n")
+	} else {
+		src.WriteString("query := " + expr + "\n")
+		for _, key := range rest {
+			src.WriteString("query[" + strconv.Quote(key) + "] = " + g.literal(body[key]) + "\n")
+		}
+		src.WriteString("return query\n")
+	}
+	src.WriteString("}\n")
+
+	formatted, err := format.Source([]byte(src.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+func (g *generator) use(path, alias string) {
+	g.imports[path] = alias
+}
+
+// importDecl renders the import block with enum packages first, the way the
+// package's own sources group them.
+func (g *generator) importDecl() string {
+	var enums, others []string
+	for path, alias := range g.imports {
+		spec := strconv.Quote(path)
+		if alias != "" {
+			spec = alias + " " + spec
+		}
+		if strings.HasPrefix(path, enumsPath) {
+			enums = append(enums, spec)
+		} else {
+			others = append(others, spec)
+		}
+	}
+	sort.Strings(enums)
+	sort.Strings(others)
+
+	var decl strings.Builder
+	decl.WriteString("import (\n")
+	for _, spec := range enums {
+		decl.WriteString(spec + "\n")
+	}
+	if len(enums) > 0 {
+		decl.WriteString("\n")
+	}
+	for _, spec := range others {
+		decl.WriteString(spec + "\n")
+	}
+	decl.WriteString(")\n")
+	return decl.String()
+}
+
+// root renders the search body as an es.Object chain and returns the keys
+// that have to be assigned as literals afterwards.
+func (g *generator) root(body map[string]any) (string, []string) {
+	handled := map[string]bool{}
+	var expr string
+	if query, isObject := body["query"].(map[string]any); isObject {
+		expr = "es.NewQuery(" + g.query(query) + ")"
+		handled["query"] = true
+	} else {
+		expr = "es.Object{}"
+	}
+	for _, key := range rootKeys[1:] {
+		value, exists := body[key]
+		if !exists {
+			continue
+		}
+		if call, ok := g.try(func() (string, bool) { return g.rootMethod(key, value) }); ok {
+			expr += ".\n" + call
+			handled[key] = true
+		}
+	}
+
+	var rest []string
+	for _, key := range sortedKeys(body) {
+		if !handled[key] {
+			rest = append(rest, key)
+		}
+	}
+	return expr, rest
+}
+
+// rootMethod renders the es.Object method call producing key, if any.
+func (g *generator) rootMethod(key string, value any) (string, bool) {
+	switch key {
+	case "aggs":
+		aggs, ok := value.(map[string]any)
+		if !ok || len(aggs) == 0 {
+			return "", false
+		}
+		return "Aggs(" + g.aggs(aggs) + ")", true
+	case "size", "from":
+		arg, ok := intLiteral(value)
+		return upperFirst(key) + "(" + arg + ")", ok
+	case "track_total_hits":
+		b, ok := value.(bool)
+		return "TrackTotalHits(" + strconv.FormatBool(b) + ")", ok
+	case "_source":
+		return g.source(value)
+	case "sort":
+		sorts, ok := g.sorts(value)
+		return "Sort(" + sorts + ")", ok
+	case "post_filter":
+		if _, isObject := value.(map[string]any); !isObject {
+			return "", false
+		}
+		return "PostFilter(" + g.query(value) + ")", true
+	case "search_after":
+		values, ok := value.([]any)
+		if !ok || len(values) == 0 {
+			return "", false
+		}
+		return "SearchAfter(" + g.literals(values) + ")", true
+	}
+	return "", false
+}
+
+// source renders "_source" as SourceFalse or SourceIncludes/SourceExcludes.
+func (g *generator) source(value any) (string, bool) {
+	switch v := value.(type) {
+	case bool:
+		return "SourceFalse()", !v
+	case map[string]any:
+		var calls []string
+		for _, key := range sortedKeys(v) {
+			method := map[string]string{"excludes": "SourceExcludes", "includes": "SourceIncludes"}[key]
+			fields, isArray := v[key].([]any)
+			list, ok := stringList(v[key])
+			if method == "" || !isArray || len(fields) == 0 || !ok {
+				return "", false
+			}
+			calls = append(calls, method+"("+list+")")
+		}
+		return strings.Join(calls, ".\n"), len(calls) > 0
+	}
+	return "", false
+}
+
+func upperFirst(s string) string {
+	return strings.ToUpper(s[:1]) + s[1:]
+}
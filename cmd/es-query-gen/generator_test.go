@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+// roundTripBodies are written in the long form the builder produces so the
+// generated code has to marshal back to exactly the same JSON.
+// nolint:golint,lll
+var roundTripBodies = []string{
+	`{"query":{"match_all":{}}}`,
+	`{"query":{"bool":{"filter":[{"term":{"brand":{"value":"apple","case_insensitive":true}}},{"terms":{"color":["red","blue"],"boost":2}},{"terms":{"mixed":[1,"a",true]}},{"range":{"price":{"gte":10,"lt":100.5,"relation":"within"}}},{"exists":{"field":"stock"}},{"ids":{"values":["1","2"]}}],"must":[{"match":{"title":{"query":"phone","operator":"and","fuzziness":"AUTO"}}},{"match_phrase":{"title":{"query":"smart phone","slop":2}}},{"match_phrase_prefix":{"title":{"query":"sma","max_expansions":5}}},{"match_bool_prefix":{"title":{"query":"sma","minimum_should_match":"50%"}}}],"must_not":[{"prefix":{"sku":{"value":"x","rewrite":"constant_score"}}},{"wildcard":{"sku":{"value":"x*"}}},{"regexp":{"sku":{"value":"x.*","flags":"ALL"}}},{"fuzzy":{"name":{"value":"jon","fuzziness":2}}}],"should":[{"multi_match":{"query":"phone","fields":["title","description"],"type":"best_fields"}},{"query_string":{"query":"a AND b","fields":["title"],"default_operator":"and"}},{"simple_query_string":{"query":"a","fields":["title"]}}],"minimum_should_match":1,"boost":1.5}}}`,
+	`{"query":{"nested":{"path":"variants","query":{"bool":{"filter":[{"term":{"variants.color":{"value":"red"}}}]}},"score_mode":"max","inner_hits":{"size":3,"name":"v","sort":[{"variants.price":{"order":"asc"}}],"_source":{"includes":["variants.price"]}}}}}`,
+	`{"query":{"constant_score":{"filter":{"dis_max":{"queries":[{"term":{"a":{"value":1}}},{"match_none":{}}],"tie_breaker":0.7}},"boost":2}}}`,
+	`{"query":{"geo_shape":{"location":{"shape":{"type":"envelope","coordinates":[[13,53],[14,52]]}}}},"min_score":0.5,"_source":false,"size":10,"from":20,"track_total_hits":true,"search_after":[1700000000000,"id-1"],"timeout":"2s"}`,
+	`{"query":{"term":{"id":{"value":18446744073709551615}}},"post_filter":{"term":{"active":{"value":true}}},"sort":[{"date":{"order":"desc","mode":"max","nested":{"path":"p","filter":{"term":{"p.a":{"value":"b"}}},"max_children":3}}},{"_score":{}}]}`,
+	`{"size":0,"aggs":{"by_brand":{"terms":{"field":"brand","size":10,"order":[{"_count":"desc"},{"_key":"asc"}],"execution_hint":"map","include":["a","b"]},"aggs":{"avg_price":{"avg":{"field":"price","missing":0}},"top":{"top_hits":{"size":1,"sort":[{"date":{"order":"desc"}}],"_source":{"includes":["title"]}}}},"meta":{"owner":"search"}},"price_ranges":{"range":{"field":"price","ranges":[{"to":50},{"from":50,"to":100,"key":"mid"}]}},"per_day":{"date_histogram":{"field":"date","calendar_interval":"day","time_zone":"+03:00","extended_bounds":{"min":"now-7d","max":"now"}}},"prices":{"histogram":{"field":"price","interval":10,"hard_bounds":{"min":0,"max":500}}},"active":{"filter":{"term":{"active":{"value":true}}},"aggs":{"n":{"value_count":{"field":"id"}}}},"kinds":{"filters":{"filters":{"cheap":{"range":{"price":{"lt":10}}}},"other_bucket_key":"rest"}},"variants":{"nested":{"path":"variants"},"aggs":{"back":{"reverse_nested":{}},"colors":{"multi_terms":{"terms":[{"field":"variants.color"},{"field":"variants.size"}],"size":5}}}},"dates":{"date_range":{"field":"date","format":"yyyy","ranges":[{"from":"2020"}]}},"uniq":{"cardinality":{"field":"user","precision_threshold":100}},"grid":{"geohash_grid":{"field":"location","precision":3}},"stats":{"extended_stats":{"field":"price"}}}}`,
+	`{"aggregations":{"a":{"max":{"field":"price"}}},"query":{"function_score":{"query":{"match_all":{}},"functions":[{"weight":2}]}}}`,
+}
+
+func Test_Generate_should_render_builder_calls(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"bool":{"filter":[{"term":{"brand":{"value":"apple"}}}]}},"size":10,"sort":[{"date":{"order":"desc"}}]}`
+
+	// When
+	src, err := Generate([]byte(body), "queries", "BrandQuery")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, `package queries
+
+import (
+	Order "github.com/Trendyol/es-query-builder/es/enums/sort/order"
+
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+func BrandQuery() es.Object {
+	return es.NewQuery(es.Bool().
+		Filter(es.Term("brand", "apple"))).
+		Size(10).
+		Sort(es.Sort("date").
+			Order(Order.Desc))
+}
+`, string(src))
+}
+
+func Test_Generate_should_fall_back_to_object_literals(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"bool":{"filter":[{"geo_shape":{"location":{"relation":"within"}}}]}},"min_score":0.5}`
+
+	// When
+	src, err := Generate([]byte(body), "main", "buildQuery")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, `package main
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+func buildQuery() es.Object {
+	query := es.NewQuery(es.Bool().
+		Filter(es.Object{
+			"geo_shape": es.Object{
+				"location": es.Object{
+					"relation": "within",
+				},
+			},
+		}))
+	query["min_score"] = 0.5
+	return query
+}
+`, string(src))
+}
+
+func Test_Generate_should_not_import_enums_of_abandoned_clauses(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"nested":{"path":"p","query":{"match_all":{}},"score_mode":"max","unknown":true}}}`
+
+	// When
+	src, err := Generate([]byte(body), "main", "buildQuery")
+
+	// Then
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(string(src), "score-mode"))
+	assert.True(t, strings.Contains(string(src), `"score_mode": "max"`))
+}
+
+func Test_Generate_should_expand_short_forms(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"bool":{"must":{"match":{"title":"phone"}}}},"sort":["_score"]}`
+
+	// When
+	src, err := Generate([]byte(body), "main", "buildQuery")
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(string(src), `Must(es.Match("title", "phone"))`))
+	assert.True(t, strings.Contains(string(src), `Sort(es.Sort("_score"))`))
+}
+
+func Test_Generate_should_return_error_for_invalid_body(t *testing.T) {
+	t.Parallel()
+	// Given When
+	_, invalidErr := Generate([]byte(`{"query":`), "main", "buildQuery")
+	_, nullErr := Generate([]byte(`null`), "main", "buildQuery")
+
+	// Then
+	assert.NotNil(t, invalidErr)
+	assert.Equal(t, "invalid search body: expected a JSON object", nullErr.Error())
+}
+
+func Test_run_should_read_stdin_and_write_stdout(t *testing.T) {
+	t.Parallel()
+	// Given
+	var stdout bytes.Buffer
+
+	// When
+	err := run("", "main", "buildQuery", strings.NewReader(`{"query":{"match_all":{}}}`), &stdout)
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(stdout.String(), "return es.NewQuery(es.MatchAll())"))
+}
+
+func Test_Generate_should_round_trip(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles and runs the generated code")
+	}
+	// Given
+	root, err := filepath.Abs("../..")
+	assert.Nil(t, err)
+	dir := t.TempDir()
+	goMod := "module roundtrip\n\ngo 1.18\n\nrequire github.com/Trendyol/es-query-builder v0.0.0\n\n" +
+		"replace github.com/Trendyol/es-query-builder => " + root + "\n"
+	writeFile(t, filepath.Join(dir, "go.mod"), goMod)
+	calls := make([]string, 0, len(roundTripBodies))
+	for i, body := range roundTripBodies {
+		name := fmt.Sprintf("buildQuery%d", i)
+		src, genErr := Generate([]byte(body), "main", name)
+		assert.Nil(t, genErr)
+		writeFile(t, filepath.Join(dir, name+".go"), string(src))
+		calls = append(calls, name+"()")
+	}
+	writeFile(t, filepath.Join(dir, "main.go"), `package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+func main() {
+	encoder := json.NewEncoder(os.Stdout)
+	for _, query := range []es.Object{`+strings.Join(calls, ", ")+`} {
+		if err := encoder.Encode(query); err != nil {
+			panic(err)
+		}
+	}
+}
+`)
+
+	// When
+	cmd := exec.Command("go", "run", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+	output, err := cmd.CombinedOutput()
+
+	// Then
+	assert.Nil(t, err, string(output))
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	assert.Equal(t, len(roundTripBodies), len(lines))
+	for i, body := range roundTripBodies {
+		assert.Equal(t, decode(t, body), decode(t, lines[i]), body)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func decode(t *testing.T, data string) any {
+	t.Helper()
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	assert.Nil(t, decoder.Decode(&value))
+	return value
+}
@@ -0,0 +1,50 @@
+// Command es-query-gen turns a JSON search body into Go source code that
+// builds the same body with es-query-builder.
+//
+// Usage:
+//
+//	es-query-gen [-package name] [-func name] [file]
+//
+// The body is read from file, or from standard input when no file is given,
+// and the generated source is written to standard output. Clauses the builder
+// does not cover are emitted as es.Object literals, and short forms such as
+// {"term": {"field": "value"}} are written in their long form, so the
+// generated code marshals back to an equivalent body.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	packageName := flag.String("package", "main", "package name of the generated file")
+	funcName := flag.String("func", "buildQuery", "name of the generated function")
+	flag.Parse()
+
+	if err := run(flag.Arg(0), *packageName, *funcName, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "es-query-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(path, packageName, funcName string, stdin io.Reader, stdout io.Writer) error {
+	var data []byte
+	var err error
+	if path == "" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return err
+	}
+	src, err := Generate(data, packageName, funcName)
+	if err != nil {
+		return err
+	}
+	_, err = stdout.Write(src)
+	return err
+}
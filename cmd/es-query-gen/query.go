@@ -0,0 +1,554 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// clauseGenerator renders the body of a single named clause, reporting false
+// when the builder cannot express it.
+type clauseGenerator func(g *generator, body map[string]any) (string, bool)
+
+// queryGenerators is populated in init because the generators call back into
+// generator.query, which reads this map.
+var queryGenerators map[string]clauseGenerator
+
+var (
+	boostOption           = option{key: "boost", method: "Boost", kind: floatArg}
+	nameOption            = option{key: "_name", method: "Name", kind: stringArg}
+	caseInsensitiveOption = option{key: "case_insensitive", method: "CaseInsensitive", kind: boolArg}
+	rewriteOption         = option{key: "rewrite", method: "Rewrite", kind: stringArg}
+	analyzerOption        = option{key: "analyzer", method: "Analyzer", kind: stringArg}
+	fuzzinessOption       = option{key: "fuzziness", method: "Fuzziness", kind: anyArg}
+	fuzzyRewriteOption    = option{key: "fuzzy_rewrite", method: "FuzzyRewrite", kind: stringArg}
+	maxExpansionsOption   = option{key: "max_expansions", method: "MaxExpansions", kind: intArg}
+	prefixLengthOption    = option{key: "prefix_length", method: "PrefixLength", kind: intArg}
+	slopOption            = option{key: "slop", method: "Slop", kind: intArg}
+	lenientOption         = option{key: "lenient", method: "Lenient", kind: boolArg}
+	operatorOption        = option{key: "operator", method: "Operator", kind: enumArg, enum: &operatorEnum}
+	defaultOperatorOption = option{key: "default_operator", method: "DefaultOperator", kind: enumArg, enum: &operatorEnum}
+	zeroTermsQueryOption  = option{key: "zero_terms_query", method: "ZeroTermsQuery", kind: enumArg, enum: &zeroTermsQueryEnum}
+	textQueryTypeOption   = option{key: "type", method: "Type", kind: enumArg, enum: &textQueryTypeEnum}
+	tieBreakerOption      = option{key: "tie_breaker", method: "TieBreaker", kind: floatArg}
+	cutoffFrequencyOption = option{key: "cutoff_frequency", method: "CutoffFrequency", kind: floatArg}
+
+	fuzzyTranspositionsOption             = option{key: "fuzzy_transpositions", method: "FuzzyTranspositions", kind: boolArg}
+	autoGenerateSynonymsPhraseQueryOption = option{
+		key: "auto_generate_synonyms_phrase_query", method: "AutoGenerateSynonymsPhraseQuery", kind: boolArg,
+	}
+)
+
+func init() {
+	queryGenerators = map[string]clauseGenerator{
+		"bool":                boolClause,
+		"term":                termClause,
+		"terms":               termsClause,
+		"range":               rangeClause,
+		"exists":              existsClause,
+		"ids":                 idsClause,
+		"match_all":           emptyClause("es.MatchAll()", boostOption),
+		"match_none":          emptyClause("es.MatchNone()", boostOption),
+		"nested":              nestedClause,
+		"constant_score":      constantScoreClause,
+		"dis_max":             disMaxClause,
+		"multi_match":         textClause("es.MultiMatch", multiMatchOptions...),
+		"query_string":        textClause("es.QueryString", queryStringOptions...),
+		"simple_query_string": textClause("es.SimpleQueryString", simpleQueryStringOptions...),
+		"match": fieldClause("es.Match", "query", false,
+			operatorOption, boostOption, cutoffFrequencyOption, fuzzinessOption, fuzzyRewriteOption,
+			fuzzyTranspositionsOption, lenientOption, maxExpansionsOption, prefixLengthOption,
+			autoGenerateSynonymsPhraseQueryOption, zeroTermsQueryOption,
+		),
+		"match_phrase": fieldClause("es.MatchPhrase", "query", false,
+			analyzerOption, boostOption, slopOption, zeroTermsQueryOption,
+		),
+		"match_phrase_prefix": fieldClause("es.MatchPhrasePrefix", "query", false,
+			analyzerOption, boostOption, maxExpansionsOption, slopOption, zeroTermsQueryOption,
+		),
+		"match_bool_prefix": fieldClause("es.MatchBoolPrefix", "query", false,
+			analyzerOption, option{key: "minimum_should_match", method: "MinimumShouldMatch", kind: anyArg},
+			operatorOption, boostOption, fuzzinessOption, fuzzyRewriteOption, fuzzyTranspositionsOption,
+			maxExpansionsOption, prefixLengthOption,
+		),
+		"prefix":   fieldClause("es.Prefix", "value", true, caseInsensitiveOption, rewriteOption, boostOption),
+		"wildcard": fieldClause("es.Wildcard", "value", true, caseInsensitiveOption, rewriteOption, boostOption),
+		"regexp": fieldClause("es.Regexp", "value", true,
+			option{key: "flags", method: "Flags", kind: stringArg}, caseInsensitiveOption,
+			option{key: "max_determinized_states", method: "MaxDeterminizedStates", kind: intArg},
+			rewriteOption, boostOption,
+		),
+		"fuzzy": fieldClause("es.Fuzzy", "value", true,
+			fuzzinessOption, maxExpansionsOption, prefixLengthOption,
+			option{key: "transpositions", method: "Transpositions", kind: boolArg},
+			rewriteOption, caseInsensitiveOption, boostOption,
+		),
+	}
+}
+
+var multiMatchOptions = []option{
+	{key: "fields", method: "Fields", kind: variadicStringsArg},
+	textQueryTypeOption, operatorOption, analyzerOption, autoGenerateSynonymsPhraseQueryOption, boostOption,
+	cutoffFrequencyOption, fuzzinessOption, fuzzyRewriteOption, fuzzyTranspositionsOption, lenientOption,
+	maxExpansionsOption, {key: "minimum_should_match", method: "MinimumShouldMatch", kind: anyArg},
+	prefixLengthOption, slopOption, tieBreakerOption, zeroTermsQueryOption,
+}
+
+var queryStringOptions = []option{
+	{key: "default_field", method: "DefaultField", kind: stringArg},
+	{key: "fields", method: "Fields", kind: stringSliceArg},
+	textQueryTypeOption, defaultOperatorOption,
+	{key: "allow_leading_wildcard", method: "AllowLeadingWildcard", kind: boolArg},
+	{key: "analyze_wildcard", method: "AnalyzeWildcard", kind: boolArg},
+	analyzerOption, autoGenerateSynonymsPhraseQueryOption, boostOption,
+	{key: "enable_position_increments", method: "EnablePositionIncrements", kind: boolArg},
+	{key: "escape", method: "Escape", kind: boolArg},
+	{key: "fuzziness", method: "Fuzziness", kind: stringArg},
+	{key: "fuzzy_max_expansions", method: "FuzzyMaxExpansions", kind: intArg},
+	{key: "fuzzy_prefix_length", method: "FuzzyPrefixLength", kind: intArg},
+	fuzzyRewriteOption, fuzzyTranspositionsOption, lenientOption,
+	{key: "max_determinized_states", method: "MaxDeterminizedStates", kind: intArg},
+	{key: "minimum_should_match", method: "MinimumShouldMatch", kind: stringArg},
+	{key: "phrase_slop", method: "PhraseSlop", kind: floatArg},
+	{key: "quote_analyzer", method: "QuoteAnalyzer", kind: stringArg},
+	{key: "quote_field_suffix", method: "QuoteFieldSuffix", kind: stringArg},
+	rewriteOption, tieBreakerOption,
+	{key: "time_zone", method: "TimeZone", kind: stringArg},
+}
+
+var simpleQueryStringOptions = []option{
+	{key: "fields", method: "Fields", kind: stringSliceArg},
+	defaultOperatorOption,
+	{key: "analyze_wildcard", method: "AnalyzeWildcard", kind: boolArg},
+	analyzerOption, autoGenerateSynonymsPhraseQueryOption, boostOption,
+	{key: "flags", method: "Flags", kind: stringArg},
+	{key: "fuzzy_max_expansions", method: "FuzzyMaxExpansions", kind: intArg},
+	{key: "fuzzy_prefix_length", method: "FuzzyPrefixLength", kind: intArg},
+	fuzzyTranspositionsOption, lenientOption,
+	{key: "minimum_should_match", method: "MinimumShouldMatch", kind: stringArg},
+	{key: "quote_field_suffix", method: "QuoteFieldSuffix", kind: stringArg},
+}
+
+// query renders a query clause with the builder when possible and as an
+// es.Object literal otherwise.
+func (g *generator) query(value any) string {
+	if expr, ok := g.try(func() (string, bool) { return g.clause(value) }); ok {
+		return expr
+	}
+	return g.literal(value)
+}
+
+func (g *generator) clause(value any) (string, bool) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	name, body, ok := singleEntry(object)
+	if !ok {
+		return "", false
+	}
+	params, ok := body.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	generate, ok := queryGenerators[name]
+	if !ok {
+		return "", false
+	}
+	return generate(g, params)
+}
+
+// try runs generate and discards the imports it registered when it fails,
+// so abandoned attempts never leave unused imports behind.
+func (g *generator) try(generate func() (string, bool)) (string, bool) {
+	snapshot := make(map[string]string, len(g.imports))
+	for path, alias := range g.imports {
+		snapshot[path] = alias
+	}
+	expr, ok := generate()
+	if !ok {
+		g.imports = snapshot
+	}
+	return expr, ok
+}
+
+func (g *generator) queries(values []any) string {
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		items = append(items, g.query(value))
+	}
+	return strings.Join(items, ",\n")
+}
+
+// occurrence accepts both the single clause and the array form of a bool
+// occurrence type; the builder always writes the array form.
+func occurrence(value any) ([]any, bool) {
+	switch v := value.(type) {
+	case []any:
+		return v, true
+	case map[string]any:
+		return []any{v}, true
+	}
+	return nil, false
+}
+
+func boolClause(g *generator, body map[string]any) (string, bool) {
+	expr := "es.Bool()"
+	occurrences := []string{"filter", "must", "must_not", "should"}
+	for _, key := range occurrences {
+		value, exists := body[key]
+		if !exists {
+			continue
+		}
+		clauses, ok := occurrence(value)
+		if !ok {
+			return "", false
+		}
+		method := map[string]string{"filter": "Filter", "must": "Must", "must_not": "MustNot", "should": "Should"}[key]
+		expr += ".\n" + method + "(" + g.queries(clauses) + ")"
+	}
+	return g.chain(expr, body, occurrences,
+		option{key: "minimum_should_match", method: "MinimumShouldMatch", kind: anyArg},
+		option{key: "adjust_pure_negative", method: "AdjustPureNegative", kind: boolArg},
+		boostOption,
+	)
+}
+
+func termClause(g *generator, body map[string]any) (string, bool) {
+	field, value, ok := singleEntry(body)
+	if !ok {
+		return "", false
+	}
+	params, isObject := value.(map[string]any)
+	if !isObject {
+		params = map[string]any{"value": value}
+	}
+	if !isScalar(params["value"]) {
+		return "", false
+	}
+	expr := "es.Term(" + strconv.Quote(field) + ", " + g.literal(params["value"]) + ")"
+	return g.chain(expr, params, []string{"value"}, caseInsensitiveOption, boostOption)
+}
+
+func termsClause(g *generator, body map[string]any) (string, bool) {
+	var field string
+	var values []any
+	for key, value := range body {
+		if key == "boost" {
+			continue
+		}
+		list, ok := value.([]any)
+		if field != "" || !ok {
+			return "", false
+		}
+		field, values = key, list
+	}
+	if field == "" {
+		return "", false
+	}
+	return g.chain(g.terms(field, values), body, []string{field}, boostOption)
+}
+
+// terms uses es.Terms when every value shares a primitive Go type and falls
+// back to es.TermsArray otherwise.
+func (g *generator) terms(field string, values []any) string {
+	quoted := strconv.Quote(field)
+	if len(values) > 0 && sameKind(values) {
+		return "es.Terms(" + quoted + ", " + g.literals(values) + ")"
+	}
+	return "es.TermsArray(" + quoted + ", []any{" + g.literals(values) + "})"
+}
+
+func sameKind(values []any) bool {
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			if _, ok := values[0].(string); !ok {
+				return false
+			}
+		case bool:
+			if _, ok := values[0].(bool); !ok {
+				return false
+			}
+		case json.Number:
+			if _, ok := intLiteral(v); !ok {
+				return false
+			}
+			if _, ok := values[0].(json.Number); !ok {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func rangeClause(g *generator, body map[string]any) (string, bool) {
+	field, value, ok := singleEntry(body)
+	if !ok {
+		return "", false
+	}
+	params, ok := value.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	// The builder keeps only one of gt/gte and one of lt/lte.
+	_, hasGt := params["gt"]
+	_, hasGte := params["gte"]
+	_, hasLt := params["lt"]
+	_, hasLte := params["lte"]
+	if (hasGt && hasGte) || (hasLt && hasLte) {
+		return "", false
+	}
+	return g.chain("es.Range("+strconv.Quote(field)+")", params, nil,
+		option{key: "gt", method: "GreaterThan", kind: anyArg},
+		option{key: "gte", method: "GreaterThanOrEqual", kind: anyArg},
+		option{key: "lt", method: "LessThan", kind: anyArg},
+		option{key: "lte", method: "LessThanOrEqual", kind: anyArg},
+		option{key: "from", method: "From", kind: anyArg},
+		option{key: "to", method: "To", kind: anyArg},
+		option{key: "format", method: "Format", kind: stringArg},
+		option{key: "relation", method: "Relation", kind: enumArg, enum: &rangeRelationEnum},
+		boostOption,
+	)
+}
+
+func existsClause(g *generator, body map[string]any) (string, bool) {
+	field, ok := body["field"].(string)
+	if !ok {
+		return "", false
+	}
+	return g.chain("es.Exists("+strconv.Quote(field)+")", body, []string{"field"}, boostOption)
+}
+
+func idsClause(g *generator, body map[string]any) (string, bool) {
+	values, ok := body["values"].([]any)
+	if !ok || len(values) == 0 {
+		return "", false
+	}
+	list, ok := stringList(values)
+	if !ok {
+		return "", false
+	}
+	return g.chain("es.IDs("+list+")", body, []string{"values"}, boostOption, nameOption)
+}
+
+func emptyClause(constructor string, options ...option) clauseGenerator {
+	return func(g *generator, body map[string]any) (string, bool) {
+		return g.chain(constructor, body, nil, options...)
+	}
+}
+
+// fieldClause renders clauses shaped like {"field": {valueKey: value, ...}}
+// and their {"field": value} short form. When stringValue is set the
+// constructor only accepts string values.
+func fieldClause(constructor, valueKey string, stringValue bool, options ...option) clauseGenerator {
+	return func(g *generator, body map[string]any) (string, bool) {
+		field, value, ok := singleEntry(body)
+		if !ok {
+			return "", false
+		}
+		params, isObject := value.(map[string]any)
+		if !isObject {
+			params = map[string]any{valueKey: value}
+		}
+		if !isScalar(params[valueKey]) {
+			return "", false
+		}
+		if _, isString := params[valueKey].(string); stringValue && !isString {
+			return "", false
+		}
+		expr := constructor + "(" + strconv.Quote(field) + ", " + g.literal(params[valueKey]) + ")"
+		return g.chain(expr, params, []string{valueKey}, options...)
+	}
+}
+
+// textClause renders full text clauses such as multi_match whose query text
+// is the constructor argument.
+func textClause(constructor string, options ...option) clauseGenerator {
+	return func(g *generator, body map[string]any) (string, bool) {
+		if !isScalar(body["query"]) {
+			return "", false
+		}
+		return g.chain(constructor+"("+g.literal(body["query"])+")", body, []string{"query"}, options...)
+	}
+}
+
+func nestedClause(g *generator, body map[string]any) (string, bool) {
+	path, ok := body["path"].(string)
+	if !ok {
+		return "", false
+	}
+	query, ok := body["query"].(map[string]any)
+	if !ok {
+		return "", false
+	}
+	expr, ok := g.chain("es.Nested("+strconv.Quote(path)+", "+g.query(query)+")", body, []string{"path", "query", "inner_hits"},
+		option{key: "score_mode", method: "ScoreMode", kind: enumArg, enum: &scoreModeEnum},
+		option{key: "ignore_unmapped", method: "IgnoreUnmapped", kind: boolArg},
+		boostOption,
+	)
+	if !ok {
+		return "", false
+	}
+	if innerHits, exists := body["inner_hits"]; exists {
+		hits, ok := g.innerHits(innerHits)
+		if !ok {
+			return "", false
+		}
+		expr += ".\nInnerHits(" + hits + ")"
+	}
+	return expr, true
+}
+
+func (g *generator) innerHits(value any) (string, bool) {
+	body, ok := value.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	expr, ok := g.chain("es.InnerHits()", body, []string{"sort", "_source"},
+		option{key: "name", method: "Name", kind: stringArg},
+		option{key: "from", method: "From", kind: intArg},
+		option{key: "size", method: "Size", kind: intArg},
+		option{key: "explain", method: "Explain", kind: boolArg},
+		option{key: "version", method: "Version", kind: boolArg},
+		option{key: "seq_no_primary_term", method: "SeqNoPrimaryTerm", kind: boolArg},
+		option{key: "track_scores", method: "TrackScores", kind: boolArg},
+		option{key: "ignore_unmapped", method: "IgnoreUnmapped", kind: boolArg},
+		option{key: "fields", method: "Fields", kind: variadicStringsArg},
+		option{key: "stored_fields", method: "StoredFields", kind: variadicStringsArg},
+	)
+	if !ok {
+		return "", false
+	}
+	return g.hitsOptions(expr, body)
+}
+
+// hitsOptions appends the "sort" and "_source" options shared by inner hits
+// and top hits.
+func (g *generator) hitsOptions(expr string, body map[string]any) (string, bool) {
+	if value, exists := body["sort"]; exists {
+		sorts, ok := g.sorts(value)
+		if !ok {
+			return "", false
+		}
+		expr += ".\nSort(" + sorts + ")"
+	}
+	if value, exists := body["_source"]; exists {
+		source, ok := g.source(value)
+		if !ok {
+			return "", false
+		}
+		expr += ".\n" + source
+	}
+	return expr, true
+}
+
+func constantScoreClause(g *generator, body map[string]any) (string, bool) {
+	filter, ok := body["filter"].(map[string]any)
+	if !ok {
+		return "", false
+	}
+	return g.chain("es.ConstantScore("+g.query(filter)+")", body, []string{"filter"}, boostOption, nameOption)
+}
+
+func disMaxClause(g *generator, body map[string]any) (string, bool) {
+	queries, ok := body["queries"].([]any)
+	if !ok {
+		return "", false
+	}
+	return g.chain("es.DisMax("+g.queries(queries)+")", body, []string{"queries"}, tieBreakerOption, boostOption, nameOption)
+}
+
+// sorts renders a "sort" array as es.Sort calls. Plain field names are
+// written in their {"field": {}} form.
+func (g *generator) sorts(value any) (string, bool) {
+	values, ok := value.([]any)
+	if !ok || len(values) == 0 {
+		return "", false
+	}
+	items := make([]string, 0, len(values))
+	for _, item := range values {
+		sort, ok := g.sort(item)
+		if !ok {
+			return "", false
+		}
+		items = append(items, sort)
+	}
+	return strings.Join(items, ",\n"), true
+}
+
+func (g *generator) sort(value any) (string, bool) {
+	if field, ok := value.(string); ok {
+		return "es.Sort(" + strconv.Quote(field) + ")", true
+	}
+	object, ok := value.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	field, value, ok := singleEntry(object)
+	if !ok {
+		return "", false
+	}
+	params, isObject := value.(map[string]any)
+	if !isObject {
+		params = map[string]any{"order": value}
+	}
+	expr, ok := g.chain("es.Sort("+strconv.Quote(field)+")", params, []string{"nested"},
+		option{key: "order", method: "Order", kind: enumArg, enum: &orderEnum},
+		option{key: "mode", method: "Mode", kind: enumArg, enum: &modeEnum},
+	)
+	if !ok {
+		return "", false
+	}
+	if nested, exists := params["nested"]; exists {
+		nestedSort, ok := g.nestedSort(nested)
+		if !ok {
+			return "", false
+		}
+		expr += ".\nNested(" + nestedSort + ")"
+	}
+	return expr, true
+}
+
+func (g *generator) nestedSort(value any) (string, bool) {
+	body, ok := value.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	path, ok := body["path"].(string)
+	if !ok {
+		return "", false
+	}
+	expr, ok := g.chain("es.NestedSort("+strconv.Quote(path)+")", body, []string{"path", "filter", "nested"},
+		option{key: "max_children", method: "MaxChildren", kind: intArg},
+	)
+	if !ok {
+		return "", false
+	}
+	if filter, exists := body["filter"]; exists {
+		if _, isObject := filter.(map[string]any); !isObject {
+			return "", false
+		}
+		expr += ".\nFilter(" + g.query(filter) + ")"
+	}
+	if nested, exists := body["nested"]; exists {
+		nestedSort, ok := g.nestedSort(nested)
+		if !ok {
+			return "", false
+		}
+		expr += ".\nNested(" + nestedSort + ")"
+	}
+	return expr, true
+}
+
+// isScalar reports whether value can be passed to a generic constructor
+// such as es.Term without an explicit type argument.
+func isScalar(value any) bool {
+	switch value.(type) {
+	case string, bool, json.Number:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	modulePath = "github.com/Trendyol/es-query-builder"
+	esPath     = modulePath + "/es"
+	enumsPath  = esPath + "/enums/"
+	jsonPath   = "encoding/json"
+)
+
+// argKind describes the Go type a builder method expects for its argument.
+type argKind int
+
+const (
+	anyArg argKind = iota
+	intArg
+	floatArg
+	boolArg
+	stringArg
+	variadicStringsArg
+	stringSliceArg
+	enumArg
+)
+
+// enumType maps the JSON values of an Elasticsearch option to the constants
+// of the matching es/enums package.
+type enumType struct {
+	values map[string]string
+	alias  string
+	path   string
+}
+
+var (
+	orderEnum = enumType{
+		alias: "Order", path: enumsPath + "sort/order",
+		values: map[string]string{"asc": "Asc", "desc": "Desc", "_default": "Default"},
+	}
+	modeEnum = enumType{
+		alias: "Mode", path: enumsPath + "sort/mode",
+		values: map[string]string{"min": "Min", "max": "Max", "sum": "Sum", "avg": "Avg", "median": "Median", "_default": "Default"},
+	}
+	scoreModeEnum = enumType{
+		alias: "ScoreMode", path: enumsPath + "score-mode",
+		values: map[string]string{"avg": "Avg", "max": "Max", "min": "Min", "none": "None", "sum": "Sum"},
+	}
+	operatorEnum = enumType{
+		alias: "Operator", path: enumsPath + "operator",
+		values: map[string]string{"or": "Or", "and": "And", "OR": "Or", "AND": "And"},
+	}
+	zeroTermsQueryEnum = enumType{
+		alias: "ZeroTermsQuery", path: enumsPath + "zero-terms-query",
+		values: map[string]string{"all": "All", "none": "None"},
+	}
+	textQueryTypeEnum = enumType{
+		alias: "TextQueryType", path: enumsPath + "text-query-type",
+		values: map[string]string{
+			"best_fields": "Bestfields", "most_fields": "Mostfields", "cross_fields": "Crossfields",
+			"phrase": "Phrase", "phrase_prefix": "Phraseprefix", "bool_prefix": "Boolprefix",
+		},
+	}
+	rangeRelationEnum = enumType{
+		alias: "RangeRelation", path: enumsPath + "range-relation",
+		values: map[string]string{"within": "Within", "contains": "Contains", "intersects": "Intersects"},
+	}
+	collectModeEnum = enumType{
+		alias: "CollectMode", path: enumsPath + "collect-mode",
+		values: map[string]string{"breadth_first": "BreadthFirst", "depth_first": "DepthFirst"},
+	}
+	executionHintEnum = enumType{
+		alias: "ExecutionHint", path: enumsPath + "execution-hint",
+		values: map[string]string{"map": "Map", "global_ordinals": "GlobalOrdinals", "fielddata": "FieldData"},
+	}
+)
+
+// option binds a key of a JSON clause to the builder method that produces it.
+type option struct {
+	enum   *enumType
+	key    string
+	method string
+	kind   argKind
+}
+
+// argument renders value as the Go argument of the given kind, reporting
+// false when the value cannot be expressed with that kind.
+func (g *generator) argument(kind argKind, enum *enumType, value any) (string, bool) {
+	switch kind {
+	case intArg:
+		return intLiteral(value)
+	case floatArg:
+		number, ok := value.(json.Number)
+		return string(number), ok
+	case boolArg:
+		b, ok := value.(bool)
+		return strconv.FormatBool(b), ok
+	case stringArg:
+		s, ok := value.(string)
+		return strconv.Quote(s), ok
+	case variadicStringsArg:
+		return stringList(value)
+	case stringSliceArg:
+		list, ok := stringList(value)
+		return "[]string{" + list + "}", ok
+	case enumArg:
+		s, ok := value.(string)
+		if !ok {
+			return "", false
+		}
+		name, ok := enum.values[s]
+		if !ok {
+			return "", false
+		}
+		g.use(enum.path, enum.alias)
+		return enum.alias + "." + name, true
+	default:
+		return g.literal(value), value != nil
+	}
+}
+
+// chain appends a method call for every key of body that has a matching
+// option, in the order the options are declared. Keys listed in consumed are
+// expected to be handled by the caller. It reports false when body holds a
+// key or a value the builder cannot express.
+func (g *generator) chain(expr string, body map[string]any, consumed []string, options ...option) (string, bool) {
+	known := make(map[string]bool, len(consumed)+len(options))
+	for _, key := range consumed {
+		known[key] = true
+	}
+	for _, opt := range options {
+		known[opt.key] = true
+	}
+	for key := range body {
+		if !known[key] {
+			return "", false
+		}
+	}
+	for _, opt := range options {
+		value, exists := body[opt.key]
+		if !exists {
+			continue
+		}
+		arg, ok := g.argument(opt.kind, opt.enum, value)
+		if !ok {
+			return "", false
+		}
+		expr += ".\n" + opt.method + "(" + arg + ")"
+	}
+	return expr, true
+}
+
+// literal renders any decoded JSON value as a Go expression that marshals
+// back to the same JSON. Objects and arrays become es.Object and es.Array
+// literals with sorted keys.
+func (g *generator) literal(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return strconv.Quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return g.numberLiteral(v)
+	case map[string]any:
+		g.use(esPath, "")
+		if len(v) == 0 {
+			return "es.Object{}"
+		}
+		var builder strings.Builder
+		builder.WriteString("es.Object{\n")
+		for _, key := range sortedKeys(v) {
+			builder.WriteString(strconv.Quote(key) + ": " + g.literal(v[key]) + ",\n")
+		}
+		builder.WriteString("}")
+		return builder.String()
+	case []any:
+		g.use(esPath, "")
+		if len(v) == 0 {
+			return "es.Array{}"
+		}
+		var builder strings.Builder
+		builder.WriteString("es.Array{\n")
+		for _, item := range v {
+			builder.WriteString(g.literal(item) + ",\n")
+		}
+		builder.WriteString("}")
+		return builder.String()
+	default:
+		return "nil"
+	}
+}
+
+// numberLiteral keeps integers that do not fit into an int64 exact by
+// emitting them as json.Number values.
+func (g *generator) numberLiteral(number json.Number) string {
+	if isInteger(number) {
+		if _, err := number.Int64(); err != nil {
+			g.use(jsonPath, "")
+			return "json.Number(" + strconv.Quote(string(number)) + ")"
+		}
+	}
+	return string(number)
+}
+
+func (g *generator) literals(values []any) string {
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		items = append(items, g.literal(value))
+	}
+	return strings.Join(items, ", ")
+}
+
+func intLiteral(value any) (string, bool) {
+	number, ok := value.(json.Number)
+	if !ok || !isInteger(number) {
+		return "", false
+	}
+	if _, err := number.Int64(); err != nil {
+		return "", false
+	}
+	return string(number), true
+}
+
+func isInteger(number json.Number) bool {
+	return !strings.ContainsAny(string(number), ".eE")
+}
+
+func stringList(value any) (string, bool) {
+	values, ok := value.([]any)
+	if !ok {
+		return "", false
+	}
+	items := make([]string, 0, len(values))
+	for _, item := range values {
+		s, isString := item.(string)
+		if !isString {
+			return "", false
+		}
+		items = append(items, strconv.Quote(s))
+	}
+	return strings.Join(items, ", "), true
+}
+
+func sortedKeys(object map[string]any) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// singleEntry returns the only key and value of object.
+func singleEntry(object map[string]any) (string, any, bool) {
+	if len(object) != 1 {
+		return "", nil, false
+	}
+	for key, value := range object {
+		return key, value, true
+	}
+	return "", nil, false
+}
@@ -0,0 +1,59 @@
+package es
+
+type childrenAggType Object
+
+// ChildrenAgg creates a children aggregation.
+//
+// A children aggregation is a single bucket aggregation that moves from parent
+// documents to their child documents of the given relation in a join field.
+//
+// Example usage:
+//
+//	agg := es.TermsAgg("seller_city").
+//		Aggs(es.Agg("to_listings", es.ChildrenAgg("listing").
+//			Aggs(es.Agg("avg_price", es.AvgAgg("price")))))
+//
+// Parameters:
+//   - childType: The name of the child relation in the join field.
+//
+// Returns:
+//
+//	An es.childrenAggType object representing the children aggregation.
+func ChildrenAgg(childType string) childrenAggType {
+	return childrenAggType{
+		"children": Object{
+			"type": childType,
+		},
+	}
+}
+
+// Aggs adds sub-aggregations to the children aggregation, which run on the child documents.
+//
+// Parameters:
+//   - aggs: A variadic list of sub-aggregations.
+//
+// Returns:
+//
+//	An es.childrenAggType object with the specified sub-aggregations added.
+func (c childrenAggType) Aggs(aggs ...aggsType) childrenAggType {
+	return genericPutAggsInRoot(c, aggs)
+}
+
+// Meta adds metadata to the children aggregation.
+//
+// Parameters:
+//   - key: Metadata key.
+//   - value: Metadata value.
+//
+// Returns:
+//
+//	A modified es.childrenAggType with the meta field set.
+func (c childrenAggType) Meta(key string, value any) childrenAggType {
+	meta, ok := c["meta"].(Object)
+	if !ok {
+		meta = Object{}
+	}
+	meta[key] = value
+	c["meta"] = meta
+	return c
+}
@@ -0,0 +1,42 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   ChildrenAgg   ////
+
+func Test_ChildrenAgg_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.ChildrenAgg)
+}
+
+func Test_ChildrenAgg_should_create_childrenAggType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	agg := es.ChildrenAgg("listing")
+
+	// Then
+	assert.IsTypeString(t, "es.childrenAggType", agg)
+	bodyJSON := assert.MarshalWithoutError(t, agg)
+	assert.Equal(t, "{\"children\":{\"type\":\"listing\"}}", bodyJSON)
+}
+
+func Test_ChildrenAgg_should_create_json_with_sub_aggregations_and_meta(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(nil).Aggs(
+		es.Agg("to_listings", es.ChildrenAgg("listing").
+			Aggs(es.Agg("avg_price", es.AvgAgg("price"))).
+			Meta("owner", "pricing")),
+	)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"aggs\":{\"to_listings\":{\"aggs\":{\"avg_price\":{\"avg\":{\"field\":\"price\"}}},\"children\":{\"type\":\"listing\"},\"meta\":{\"owner\":\"pricing\"}}},\"query\":{}}", bodyJSON)
+}
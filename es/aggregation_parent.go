@@ -0,0 +1,59 @@
+package es
+
+type parentAggType Object
+
+// ParentAgg creates a parent aggregation.
+//
+// A parent aggregation is a single bucket aggregation that moves from child
+// documents of the given relation to their parent documents in a join field.
+//
+// Example usage:
+//
+//	agg := es.TermsAgg("category").
+//		Aggs(es.Agg("to_sellers", es.ParentAgg("listing").
+//			Aggs(es.Agg("top_sellers", es.TermsAgg("seller_name")))))
+//
+// Parameters:
+//   - childType: The name of the child relation in the join field, whose parents are aggregated.
+//
+// Returns:
+//
+//	An es.parentAggType object representing the parent aggregation.
+func ParentAgg(childType string) parentAggType {
+	return parentAggType{
+		"parent": Object{
+			"type": childType,
+		},
+	}
+}
+
+// Aggs adds sub-aggregations to the parent aggregation, which run on the parent documents.
+//
+// Parameters:
+//   - aggs: A variadic list of sub-aggregations.
+//
+// Returns:
+//
+//	An es.parentAggType object with the specified sub-aggregations added.
+func (p parentAggType) Aggs(aggs ...aggsType) parentAggType {
+	return genericPutAggsInRoot(p, aggs)
+}
+
+// Meta adds metadata to the parent aggregation.
+//
+// Parameters:
+//   - key: Metadata key.
+//   - value: Metadata value.
+//
+// Returns:
+//
+//	A modified es.parentAggType with the meta field set.
+func (p parentAggType) Meta(key string, value any) parentAggType {
+	meta, ok := p["meta"].(Object)
+	if !ok {
+		meta = Object{}
+	}
+	meta[key] = value
+	p["meta"] = meta
+	return p
+}
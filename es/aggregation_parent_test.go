@@ -0,0 +1,40 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   ParentAgg   ////
+
+func Test_ParentAgg_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.ParentAgg)
+}
+
+func Test_ParentAgg_should_create_parentAggType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	agg := es.ParentAgg("listing")
+
+	// Then
+	assert.IsTypeString(t, "es.parentAggType", agg)
+	bodyJSON := assert.MarshalWithoutError(t, agg)
+	assert.Equal(t, "{\"parent\":{\"type\":\"listing\"}}", bodyJSON)
+}
+
+func Test_ParentAgg_should_create_json_with_sub_aggregations_and_meta(t *testing.T) {
+	t.Parallel()
+	// Given
+	agg := es.ParentAgg("listing").
+		Aggs(es.Agg("top_sellers", es.TermsAgg("seller_name"))).
+		Meta("owner", "catalog")
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, agg)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"aggs\":{\"top_sellers\":{\"terms\":{\"field\":\"seller_name\"}}},\"meta\":{\"owner\":\"catalog\"},\"parent\":{\"type\":\"listing\"}}", bodyJSON)
+}
@@ -315,3 +315,493 @@ func (o Object) SearchAfter(values ...any) Object {
 	o["search_after"] = values
 	return o
 }
+
+// Collapse sets the "collapse" parameter in an es.Object.
+//
+// Field collapsing returns only the top hit of each distinct value of a field,
+// such as one product per seller. Inner hits of the collapse return more hits of
+// each group, and a collapse inside an inner hits entry groups those hits again.
+// Elasticsearch only accepts a second level collapse inside inner hits.
+//
+// Example usage:
+//
+//	query := es.NewQuery(es.Match("title", "phone")).Collapse(
+//		es.FieldCollapse("seller_id").
+//			InnerHits(
+//				es.InnerHits().Name("cheapest").Size(3).Sort(es.Sort("price").Order(Order.Asc)),
+//				es.InnerHits().Name("by_brand").Size(2).Collapse(es.FieldCollapse("brand")),
+//			).
+//			MaxConcurrentGroupSearches(4),
+//	)
+//	// query now includes a "collapse" parameter on the "seller_id" field.
+//
+// Parameters:
+//   - fieldCollapse: An es.fieldCollapseType created with es.FieldCollapse.
+//
+// Returns:
+//
+//	The updated es.Object with the "collapse" parameter set.
+func (o Object) Collapse(fieldCollapse fieldCollapseType) Object {
+	o["collapse"] = fieldCollapse
+	return o
+}
+
+// Rescore adds rescorers to the "rescore" parameter in an es.Object.
+//
+// Rescorers recompute the score of the top hits of each shard, one after the
+// other, so an expensive query only runs on the hits that matter.
+//
+// Example usage:
+//
+//	query := es.NewQuery(es.Match("title", "running shoes")).Rescore(
+//		es.Rescorer(500).Query(es.RescoreQuery(es.MatchPhrase("title", "running shoes")).RescoreQueryWeight(2)),
+//		es.Rescorer(50).LearningToRank(es.LearningToRank("product-ltr").Param("query_text", "running shoes")),
+//	)
+//	// query now includes a "rescore" parameter with both rescorers, applied in order.
+//
+// Parameters:
+//   - rescorers: The es.rescoreType values created with es.Rescorer.
+//
+// Returns:
+//
+//	The updated es.Object with the "rescore" parameter set.
+func (o Object) Rescore(rescorers ...rescoreType) Object {
+	if len(rescorers) == 0 {
+		return o
+	}
+	rescore, ok := o["rescore"].([]rescoreType)
+	if !ok {
+		rescore = make([]rescoreType, 0, len(rescorers))
+	}
+	o["rescore"] = append(rescore, rescorers...)
+	return o
+}
+
+// TrackTotalHitsUpTo sets the "track_total_hits" parameter in an es.Object to an integer threshold.
+//
+// Total hits are counted accurately up to the threshold. Above it, the response
+// reports the threshold as a lower bound, which keeps counting cheap on large result sets.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).TrackTotalHitsUpTo(100_000)
+//	// query now includes a "track_total_hits" parameter with a value of 100000.
+//
+// Parameters:
+//   - threshold: The number of hits to count accurately.
+//
+// Returns:
+//
+//	The updated es.Object with the "track_total_hits" parameter set.
+func (o Object) TrackTotalHitsUpTo(threshold int) Object {
+	o["track_total_hits"] = threshold
+	return o
+}
+
+// MinScore sets the "min_score" parameter in an es.Object.
+//
+// Hits with a score lower than the minimum are left out of the search results.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).MinScore(0.5)
+//	// query now includes a "min_score" parameter with a value of 0.5.
+//
+// Parameters:
+//   - minScore: The minimum score a hit needs to be returned.
+//
+// Returns:
+//
+//	The updated es.Object with the "min_score" parameter set.
+func (o Object) MinScore(minScore float64) Object {
+	o["min_score"] = minScore
+	return o
+}
+
+// Timeout sets the "timeout" parameter in an es.Object.
+//
+// When the timeout expires, each shard returns the hits collected so far and
+// the response is marked with "timed_out": true.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).Timeout("2s")
+//	// query now includes a "timeout" parameter with a value of "2s".
+//
+// Parameters:
+//   - timeout: A time unit string such as "500ms" or "2s".
+//
+// Returns:
+//
+//	The updated es.Object with the "timeout" parameter set.
+func (o Object) Timeout(timeout string) Object {
+	o["timeout"] = timeout
+	return o
+}
+
+// TerminateAfter sets the "terminate_after" parameter in an es.Object.
+//
+// Each shard stops collecting documents once it reaches this number, and the
+// response is marked with "terminated_early": true.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).TerminateAfter(1000)
+//	// query now includes a "terminate_after" parameter with a value of 1000.
+//
+// Parameters:
+//   - terminateAfter: The maximum number of documents to collect per shard.
+//
+// Returns:
+//
+//	The updated es.Object with the "terminate_after" parameter set.
+func (o Object) TerminateAfter(terminateAfter int) Object {
+	o["terminate_after"] = terminateAfter
+	return o
+}
+
+// Explain sets the "explain" parameter in an es.Object.
+//
+// When enabled, each hit includes a detailed explanation of how its score was computed.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).Explain(true)
+//	// query now includes an "explain" parameter with a value of true.
+//
+// Parameters:
+//   - explain: A boolean enabling or disabling score explanations.
+//
+// Returns:
+//
+//	The updated es.Object with the "explain" parameter set.
+func (o Object) Explain(explain bool) Object {
+	o["explain"] = explain
+	return o
+}
+
+// Version sets the "version" parameter in an es.Object.
+//
+// When enabled, each hit includes the version of its document.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).Version(true)
+//	// query now includes a "version" parameter with a value of true.
+//
+// Parameters:
+//   - version: A boolean enabling or disabling document versions in hits.
+//
+// Returns:
+//
+//	The updated es.Object with the "version" parameter set.
+func (o Object) Version(version bool) Object {
+	o["version"] = version
+	return o
+}
+
+// SeqNoPrimaryTerm sets the "seq_no_primary_term" parameter in an es.Object.
+//
+// When enabled, each hit includes the sequence number and primary term of its
+// document, which are used for optimistic concurrency control.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).SeqNoPrimaryTerm(true)
+//	// query now includes a "seq_no_primary_term" parameter with a value of true.
+//
+// Parameters:
+//   - seqNoPrimaryTerm: A boolean enabling or disabling "_seq_no" and "_primary_term" in hits.
+//
+// Returns:
+//
+//	The updated es.Object with the "seq_no_primary_term" parameter set.
+func (o Object) SeqNoPrimaryTerm(seqNoPrimaryTerm bool) Object {
+	o["seq_no_primary_term"] = seqNoPrimaryTerm
+	return o
+}
+
+// TrackScores sets the "track_scores" parameter in an es.Object.
+//
+// Scores are not computed when results are sorted on a field. Enabling
+// track_scores computes them anyway.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).Sort(es.Sort("date")).TrackScores(true)
+//	// query now includes a "track_scores" parameter with a value of true.
+//
+// Parameters:
+//   - trackScores: A boolean enabling or disabling score tracking.
+//
+// Returns:
+//
+//	The updated es.Object with the "track_scores" parameter set.
+func (o Object) TrackScores(trackScores bool) Object {
+	o["track_scores"] = trackScores
+	return o
+}
+
+// StoredFields sets the "stored_fields" parameter in an es.Object.
+//
+// Only fields mapped with "store": true can be retrieved this way. Pass "_none_"
+// to disable stored fields and metadata, including "_source".
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).StoredFields("title", "date")
+//	// query now includes a "stored_fields" parameter with ["title", "date"].
+//
+// Parameters:
+//   - fields: The names of the stored fields to return.
+//
+// Returns:
+//
+//	The updated es.Object with the "stored_fields" parameter set.
+func (o Object) StoredFields(fields ...string) Object {
+	o["stored_fields"] = fields
+	return o
+}
+
+// DocvalueFields sets the "docvalue_fields" parameter in an es.Object.
+//
+// Doc value fields are read from the columnar doc values instead of "_source",
+// optionally with a custom format.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).DocvalueFields(
+//		es.FieldAndFormat("date").Format("epoch_millis"),
+//		es.FieldAndFormat("price"),
+//	)
+//	// query now includes a "docvalue_fields" parameter with both fields.
+//
+// Parameters:
+//   - fieldAndFormat: The es.fieldAndFormatType values created with es.FieldAndFormat.
+//
+// Returns:
+//
+//	The updated es.Object with the "docvalue_fields" parameter set.
+func (o Object) DocvalueFields(fieldAndFormat ...fieldAndFormatType) Object {
+	o["docvalue_fields"] = fieldAndFormat
+	return o
+}
+
+// Fields sets the "fields" parameter in an es.Object.
+//
+// The fields API returns values as they are indexed, following the mapping, and
+// supports wildcard patterns, formats and unmapped fields.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).Fields(
+//		es.FieldAndFormat("user.*"),
+//		es.FieldAndFormat("date").Format("yyyy-MM-dd"),
+//	)
+//	// query now includes a "fields" parameter with both fields.
+//
+// Parameters:
+//   - fieldAndFormat: The es.fieldAndFormatType values created with es.FieldAndFormat.
+//
+// Returns:
+//
+//	The updated es.Object with the "fields" parameter set.
+func (o Object) Fields(fieldAndFormat ...fieldAndFormatType) Object {
+	o["fields"] = fieldAndFormat
+	return o
+}
+
+// ScriptField adds a script field to the "script_fields" parameter in an es.Object.
+//
+// Script fields are computed for each hit by the given script.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).ScriptField(
+//		"discounted_price",
+//		es.ScriptField(es.ScriptSource("doc['price'].value * 0.9", ScriptLanguage.Painless)),
+//	)
+//	// query now includes a "script_fields" parameter with the "discounted_price" field.
+//
+// Parameters:
+//   - name: The name of the field in the hits.
+//   - scriptField: An es.scriptFieldType created with es.ScriptField.
+//
+// Returns:
+//
+//	The updated es.Object with the script field added to "script_fields".
+func (o Object) ScriptField(name string, scriptField scriptFieldType) Object {
+	scriptFields, ok := o["script_fields"].(scriptFieldsType)
+	if !ok {
+		scriptFields = scriptFieldsType{}
+	}
+	scriptFields[name] = scriptField
+	o["script_fields"] = scriptFields
+	return o
+}
+
+// IndicesBoost adds an index boost to the "indices_boost" parameter in an es.Object.
+//
+// The scores of documents from the index are multiplied by the boost when
+// searching several indices. Boosts are kept in the order they are added.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).IndicesBoost("products-2024", 1.4).IndicesBoost("products-*", 1.1)
+//	// query now includes "indices_boost": [{"products-2024": 1.4}, {"products-*": 1.1}].
+//
+// Parameters:
+//   - index: The index name, alias or wildcard pattern.
+//   - boost: The boost applied to the scores of the index.
+//
+// Returns:
+//
+//	The updated es.Object with the boost added to "indices_boost".
+func (o Object) IndicesBoost(index string, boost float64) Object {
+	indicesBoost, ok := o["indices_boost"].([]GenericObject[float64])
+	if !ok {
+		indicesBoost = make([]GenericObject[float64], 0, 1)
+	}
+	o["indices_boost"] = append(indicesBoost, GenericObject[float64]{index: boost})
+	return o
+}
+
+// Stats sets the "stats" parameter in an es.Object.
+//
+// The search is counted in the given statistics groups, which can be read with
+// the index stats API.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).Stats("storefront", "search-page")
+//	// query now includes a "stats" parameter with ["storefront", "search-page"].
+//
+// Parameters:
+//   - groups: The names of the statistics groups.
+//
+// Returns:
+//
+//	The updated es.Object with the "stats" parameter set.
+func (o Object) Stats(groups ...string) Object {
+	o["stats"] = groups
+	return o
+}
+
+// Profile sets the "profile" parameter in an es.Object.
+//
+// When enabled, the response includes timing details of the query and
+// aggregation phases on each shard. It is meant for debugging slow searches.
+//
+// Example usage:
+//
+//	query := es.NewQuery(...).Profile(true)
+//	// query now includes a "profile" parameter with a value of true.
+//
+// Parameters:
+//   - profile: A boolean enabling or disabling profiling.
+//
+// Returns:
+//
+//	The updated es.Object with the "profile" parameter set.
+func (o Object) Profile(profile bool) Object {
+	o["profile"] = profile
+	return o
+}
+
+// RuntimeMappings adds runtime fields to the "runtime_mappings" parameter in an es.Object.
+//
+// Runtime fields are computed by scripts at query time and can be used in
+// queries, sorts and aggregations of the same request without reindexing.
+// A runtime field with the name of a mapped field shadows it.
+//
+// Example usage:
+//
+//	query := es.NewQuery(es.Range("margin").GreaterThan(10)).
+//		RuntimeMappings(
+//			es.RuntimeField("margin", RuntimeFieldType.Double).
+//				Script(es.ScriptSource("emit(doc['price'].value - doc['cost'].value)", ScriptLanguage.Painless)),
+//		).
+//		Sort(es.Sort("margin").Order(Order.Desc))
+//	// query now includes a "runtime_mappings" parameter with the "margin" field.
+//
+// Parameters:
+//   - fields: The es.RuntimeMappingType values created with es.RuntimeField.
+//
+// Returns:
+//
+//	The updated es.Object with the fields added to "runtime_mappings".
+func (o Object) RuntimeMappings(fields ...RuntimeMappingType) Object {
+	runtimeMappings, ok := o["runtime_mappings"].(Object)
+	if !ok {
+		runtimeMappings = Object{}
+		o["runtime_mappings"] = runtimeMappings
+	}
+	mergeRuntimeFields(runtimeMappings, fields)
+	return o
+}
+
+// Knn sets the top-level "knn" section in an es.Object.
+//
+// Top-level knn searches run next to the "query", and the scores of both are
+// summed, which combines lexical and vector retrieval. A single search is set
+// as an object, several searches as an array. Each search is copied, like in
+// es.KnnRetriever, so changing a builder later does not change the query.
+//
+// Example usage:
+//
+//	query := es.NewQuery(es.Match("title", "running shoes")).Knn(
+//		es.Knn("title_embedding").QueryVector(vector...).K(10).NumCandidates(100).Boost(0.4),
+//	)
+//	// query now includes a "knn" section with the search on "title_embedding".
+//
+// Parameters:
+//   - knns: The es.knnType values created with es.Knn.
+//
+// Returns:
+//
+//	The updated es.Object with the "knn" section set.
+func (o Object) Knn(knns ...knnType) Object {
+	searches := make(Array, 0, len(knns))
+	for i := 0; i < len(knns); i++ {
+		if _, ok := knns[i]["knn"].(Object); ok {
+			searches = append(searches, knns[i].search())
+		}
+	}
+	switch {
+	case len(searches) == 1:
+		o["knn"] = searches[0]
+	case len(searches) > 1:
+		o["knn"] = searches
+	}
+	return o
+}
+
+// Retriever sets the "retriever" parameter in an es.Object.
+//
+// A retriever tree replaces the "query" and "knn" sections of the search
+// body, so an empty "query" left by es.NewQuery(nil) is removed. Use es.Validate
+// to detect bodies that still combine a retriever with a query or knn search.
+//
+// Example usage:
+//
+//	query := es.NewQuery(nil).Retriever(
+//		es.RRFRetriever(
+//			es.StandardRetriever(es.Match("title", "running shoes")),
+//			es.KnnRetriever(es.Knn("title_embedding").QueryVector(vector...).K(10).NumCandidates(100)),
+//		).RankWindowSize(50),
+//	)
+//	// query now contains only the "retriever" parameter.
+//
+// Parameters:
+//   - retriever: An es.retrieverType created with one of the retriever constructors.
+//
+// Returns:
+//
+//	The updated es.Object with the "retriever" parameter set.
+func (o Object) Retriever(retriever retrieverType) Object {
+	if query, ok := o["query"].(Object); ok && len(query) == 0 {
+		delete(o, "query")
+	}
+	o["retriever"] = retriever
+	return o
+}
@@ -3,6 +3,9 @@ package es_test
 import (
 	"testing"
 
+	ScriptLanguage "github.com/Trendyol/es-query-builder/es/enums/script-language"
+	Order "github.com/Trendyol/es-query-builder/es/enums/sort/order"
+
 	"github.com/Trendyol/es-query-builder/es"
 	"github.com/Trendyol/es-query-builder/test/assert"
 )
@@ -454,3 +457,120 @@ func Test_SearchAfter_with_Sort_should_create_correct_json(t *testing.T) {
 	bodyJSON := assert.MarshalWithoutError(t, query)
 	assert.Equal(t, "{\"query\":{\"match_all\":{}},\"search_after\":[100,\"abc\"],\"size\":10}", bodyJSON)
 }
+
+////   Collapse   ////
+
+func Test_Collapse_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given
+	b := es.NewQuery(nil)
+
+	// When Then
+	assert.NotNil(t, b.Collapse)
+}
+
+func Test_Collapse_should_add_collapse_field_into_Object(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.MatchAll()).
+		Collapse(es.FieldCollapse("seller_id").MaxConcurrentGroupSearches(4))
+
+	// When Then
+	assert.NotNil(t, query)
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	assert.Equal(t, "{\"collapse\":{\"field\":\"seller_id\",\"max_concurrent_group_searches\":4},\"query\":{\"match_all\":{}}}", bodyJSON)
+}
+
+func Test_Collapse_should_create_json_with_named_inner_hits_and_second_level_collapse(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Match("title", "phone")).Collapse(
+		es.FieldCollapse("seller_id").
+			InnerHits(
+				es.InnerHits().Name("cheapest").Size(3).Sort(es.Sort("price").Order(Order.Asc)),
+				es.InnerHits().Name("by_brand").Size(2).Collapse(es.FieldCollapse("brand")),
+			),
+	)
+
+	// When Then
+	assert.NotNil(t, query)
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"collapse\":{\"field\":\"seller_id\",\"inner_hits\":[{\"name\":\"cheapest\",\"size\":3,\"sort\":[{\"price\":{\"order\":\"asc\"}}]},{\"collapse\":{\"field\":\"brand\"},\"name\":\"by_brand\",\"size\":2}]},\"query\":{\"match\":{\"title\":{\"query\":\"phone\"}}}}", bodyJSON)
+}
+
+////   Search body parameters   ////
+
+func Test_TrackTotalHitsUpTo_should_set_integer_threshold(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(nil).TrackTotalHits(true)
+
+	// When
+	object := query.TrackTotalHitsUpTo(100000)
+
+	// Then
+	assert.NotNil(t, object)
+	assert.Equal(t, 100000, query["track_total_hits"])
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	assert.Equal(t, "{\"query\":{},\"track_total_hits\":100000}", bodyJSON)
+}
+
+func Test_Object_should_set_scalar_search_parameters(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.MatchAll()).
+		MinScore(0.5).
+		Timeout("2s").
+		TerminateAfter(1000).
+		Explain(true).
+		Version(true).
+		SeqNoPrimaryTerm(true).
+		TrackScores(true).
+		Profile(false)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"explain\":true,\"min_score\":0.5,\"profile\":false,\"query\":{\"match_all\":{}},\"seq_no_primary_term\":true,\"terminate_after\":1000,\"timeout\":\"2s\",\"track_scores\":true,\"version\":true}", bodyJSON)
+}
+
+func Test_Object_should_set_field_retrieval_parameters(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(nil).
+		StoredFields("title", "date").
+		DocvalueFields(es.FieldAndFormat("date").Format("epoch_millis"), es.FieldAndFormat("price")).
+		Fields(es.FieldAndFormat("user.*"), es.FieldAndFormat("created_at").Format("yyyy-MM-dd")).
+		Stats("storefront", "search-page")
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"docvalue_fields\":[{\"field\":\"date\",\"format\":\"epoch_millis\"},{\"field\":\"price\"}],\"fields\":[{\"field\":\"user.*\"},{\"field\":\"created_at\",\"format\":\"yyyy-MM-dd\"}],\"query\":{},\"stats\":[\"storefront\",\"search-page\"],\"stored_fields\":[\"title\",\"date\"]}", bodyJSON)
+}
+
+func Test_ScriptField_should_add_script_fields_into_Object(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(nil).
+		ScriptField("discounted", es.ScriptField(es.ScriptSource("doc['price'].value * 0.9", ScriptLanguage.Painless))).
+		ScriptField("tax", es.ScriptField(es.ScriptID("tax-script", ScriptLanguage.Painless)).IgnoreFailure(true))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{},\"script_fields\":{\"discounted\":{\"script\":{\"lang\":\"painless\",\"source\":\"doc['price'].value * 0.9\"}},\"tax\":{\"ignore_failure\":true,\"script\":{\"id\":\"tax-script\",\"lang\":\"painless\"}}}}", bodyJSON)
+}
+
+func Test_IndicesBoost_should_append_boosts_in_order(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(nil).
+		IndicesBoost("products-2024", 1.4).
+		IndicesBoost("products-*", 1.1)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	assert.Equal(t, "{\"indices_boost\":[{\"products-2024\":1.4},{\"products-*\":1.1}],\"query\":{}}", bodyJSON)
+}
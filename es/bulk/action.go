@@ -0,0 +1,340 @@
+// Package bulk builds requests of the Elasticsearch _bulk API, streams them as
+// newline-delimited JSON and decodes the per-item results of bulk responses.
+package bulk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Trendyol/es-query-builder/es"
+	VersionType "github.com/Trendyol/es-query-builder/es/enums/version-type"
+)
+
+// actionType is a single bulk operation: an action line with its metadata and,
+// except for deletes, a source line. An update option set on another action is
+// recorded in err and returned when the action is written.
+type actionType struct {
+	metadata es.Object
+	source   any
+	err      error
+	name     string
+}
+
+func newAction(name string, source any) actionType {
+	return actionType{
+		metadata: es.Object{},
+		source:   source,
+		name:     name,
+	}
+}
+
+// Index creates an "index" action, which adds the document or replaces it when
+// a document with the same _id exists.
+//
+// Example usage:
+//
+//	a := bulk.Index(product).Index("products").ID("42")
+//	// a is written as {"index":{"_id":"42","_index":"products"}} followed by the document.
+//
+// Parameters:
+//   - document: The document, marshaled with encoding/json.
+//
+// Returns:
+//
+//	A bulk.actionType for the "index" operation.
+func Index(document any) actionType {
+	return newAction("index", document)
+}
+
+// Create creates a "create" action, which adds the document and fails when a
+// document with the same _id exists.
+//
+// Parameters:
+//   - document: The document, marshaled with encoding/json.
+//
+// Returns:
+//
+//	A bulk.actionType for the "create" operation.
+func Create(document any) actionType {
+	return newAction("create", document)
+}
+
+// Update creates an "update" action for the document with the given _id. The
+// change is set with Doc for partial documents or Script for scripted updates.
+//
+// Example usage:
+//
+//	a := bulk.Update("42").Doc(map[string]any{"stock": 0}).DocAsUpsert(true)
+//	s := bulk.Update("42").Script(es.ScriptSource("ctx._source.stock -= params.n", ScriptLanguage.Painless).Parameter("n", 1))
+//
+// Parameters:
+//   - id: The _id of the document to update.
+//
+// Returns:
+//
+//	A bulk.actionType for the "update" operation.
+func Update(id string) actionType {
+	return newAction("update", es.Object{}).ID(id)
+}
+
+// Delete creates a "delete" action for the document with the given _id.
+//
+// Parameters:
+//   - id: The _id of the document to delete.
+//
+// Returns:
+//
+//	A bulk.actionType for the "delete" operation, which has no source line.
+func Delete(id string) actionType {
+	return newAction("delete", nil).ID(id)
+}
+
+// Index sets the "_index" the action targets. It can be omitted when the
+// index is given in the URL of the bulk request.
+//
+// Parameters:
+//   - index: The index, alias or data stream name.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "_index" set.
+func (a actionType) Index(index string) actionType {
+	a.metadata["_index"] = index
+	return a
+}
+
+// ID sets the "_id" of the document.
+//
+// Parameters:
+//   - id: The document id.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "_id" set.
+func (a actionType) ID(id string) actionType {
+	a.metadata["_id"] = id
+	return a
+}
+
+// Routing sets the routing value used to select the shard of the document.
+//
+// Parameters:
+//   - routing: The routing value.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "routing" set.
+func (a actionType) Routing(routing string) actionType {
+	a.metadata["routing"] = routing
+	return a
+}
+
+// IfSeqNo makes the action fail unless the document has this sequence number.
+// It is used together with IfPrimaryTerm for optimistic concurrency control.
+//
+// Parameters:
+//   - seqNo: The expected "_seq_no" of the document.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "if_seq_no" set.
+func (a actionType) IfSeqNo(seqNo int64) actionType {
+	a.metadata["if_seq_no"] = seqNo
+	return a
+}
+
+// IfPrimaryTerm makes the action fail unless the document has this primary term.
+//
+// Parameters:
+//   - primaryTerm: The expected "_primary_term" of the document.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "if_primary_term" set.
+func (a actionType) IfPrimaryTerm(primaryTerm int64) actionType {
+	a.metadata["if_primary_term"] = primaryTerm
+	return a
+}
+
+// Version sets the version checked against the current version of the document.
+//
+// Parameters:
+//   - version: The document version.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "version" set.
+func (a actionType) Version(version int64) actionType {
+	a.metadata["version"] = version
+	return a
+}
+
+// VersionType sets how the version is checked.
+//
+// Parameters:
+//   - versionType: A VersionType.VersionType value.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "version_type" set.
+func (a actionType) VersionType(versionType VersionType.VersionType) actionType {
+	a.metadata["version_type"] = versionType
+	return a
+}
+
+// Pipeline sets the ingest pipeline the document of an index or create action goes through.
+//
+// Parameters:
+//   - pipeline: The pipeline id.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "pipeline" set.
+func (a actionType) Pipeline(pipeline string) actionType {
+	a.metadata["pipeline"] = pipeline
+	return a
+}
+
+// RequireAlias makes the action fail unless the target is an alias.
+//
+// Parameters:
+//   - requireAlias: A boolean enabling or disabling the check.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "require_alias" set.
+func (a actionType) RequireAlias(requireAlias bool) actionType {
+	a.metadata["require_alias"] = requireAlias
+	return a
+}
+
+// RetryOnConflict sets how many times an update action is retried on version conflicts.
+//
+// Parameters:
+//   - retryOnConflict: The number of retries.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "retry_on_conflict" set.
+func (a actionType) RetryOnConflict(retryOnConflict int) actionType {
+	a.metadata["retry_on_conflict"] = retryOnConflict
+	return a
+}
+
+// Doc sets the partial document merged into the existing document by an update
+// action. Setting it on another action makes writing the action fail.
+//
+// Parameters:
+//   - partial: The fields to update, marshaled with encoding/json.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "doc" set in its source line.
+func (a actionType) Doc(partial any) actionType {
+	return a.putInTheUpdate("doc", partial)
+}
+
+// DocAsUpsert makes an update action index the partial document when the
+// document does not exist. Setting it on another action makes writing the
+// action fail.
+//
+// Parameters:
+//   - docAsUpsert: A boolean enabling or disabling doc_as_upsert.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "doc_as_upsert" set in its source line.
+func (a actionType) DocAsUpsert(docAsUpsert bool) actionType {
+	return a.putInTheUpdate("doc_as_upsert", docAsUpsert)
+}
+
+// Script sets the script that modifies the document of an update action.
+// Setting it on another action makes writing the action fail.
+//
+// Parameters:
+//   - script: A script created with es.ScriptSource or es.ScriptID.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "script" set in its source line.
+func (a actionType) Script(script any) actionType {
+	return a.putInTheUpdate("script", script)
+}
+
+// Upsert sets the document indexed by an update action when the document does
+// not exist. Setting it on another action makes writing the action fail.
+//
+// Parameters:
+//   - document: The document to insert, marshaled with encoding/json.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "upsert" set in its source line.
+func (a actionType) Upsert(document any) actionType {
+	return a.putInTheUpdate("upsert", document)
+}
+
+// ScriptedUpsert makes an update action run its script when the document does
+// not exist, starting from the upsert document. Setting it on another action
+// makes writing the action fail.
+//
+// Parameters:
+//   - scriptedUpsert: A boolean enabling or disabling scripted_upsert.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "scripted_upsert" set in its source line.
+func (a actionType) ScriptedUpsert(scriptedUpsert bool) actionType {
+	return a.putInTheUpdate("scripted_upsert", scriptedUpsert)
+}
+
+// DetectNoop sets whether an update action that does not change the document
+// is reported as "noop". Setting it on another action makes writing the action
+// fail.
+//
+// Parameters:
+//   - detectNoop: A boolean enabling or disabling noop detection.
+//
+// Returns:
+//
+//	The updated bulk.actionType with "detect_noop" set in its source line.
+func (a actionType) DetectNoop(detectNoop bool) actionType {
+	return a.putInTheUpdate("detect_noop", detectNoop)
+}
+
+func (a actionType) putInTheUpdate(key string, value any) actionType {
+	update, ok := a.source.(es.Object)
+	if !ok || a.name != "update" {
+		if a.err == nil {
+			a.err = fmt.Errorf("bulk: %q can only be set on an update action, not on %s", key, a.name)
+		}
+		return a
+	}
+	update[key] = value
+	return a
+}
+
+// appendTo appends the NDJSON lines of the action to buf.
+func (a actionType) appendTo(buf *bytes.Buffer) error {
+	if a.err != nil {
+		return a.err
+	}
+	line, err := json.Marshal(es.Object{a.name: a.metadata})
+	if err != nil {
+		return err
+	}
+	var source []byte
+	if a.name != "delete" {
+		if source, err = json.Marshal(a.source); err != nil {
+			return err
+		}
+	}
+	buf.Write(line)
+	buf.WriteByte('\n')
+	if source != nil {
+		buf.Write(source)
+		buf.WriteByte('\n')
+	}
+	return nil
+}
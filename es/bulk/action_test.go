@@ -0,0 +1,143 @@
+package bulk_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	ScriptLanguage "github.com/Trendyol/es-query-builder/es/enums/script-language"
+	VersionType "github.com/Trendyol/es-query-builder/es/enums/version-type"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/es/bulk"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+type product struct {
+	Name  string `json:"name"`
+	Stock int    `json:"stock"`
+}
+
+func ndjson(t *testing.T, request io.WriterTo) string {
+	t.Helper()
+	var buf bytes.Buffer
+	_, err := request.WriteTo(&buf)
+	assert.Nil(t, err)
+	return buf.String()
+}
+
+////   Actions   ////
+
+func Test_actions_should_exist_on_bulk_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, bulk.Index)
+	assert.NotNil(t, bulk.Create)
+	assert.NotNil(t, bulk.Update)
+	assert.NotNil(t, bulk.Delete)
+	assert.IsTypeString(t, "bulk.actionType", bulk.Delete("1"))
+}
+
+func Test_Index_and_Create_should_write_metadata_and_document_lines(t *testing.T) {
+	t.Parallel()
+	// Given
+	request := bulk.NewRequest(
+		bulk.Index(product{Name: "phone", Stock: 3}).
+			Index("products").
+			ID("1").
+			Routing("user-1").
+			Pipeline("enrich").
+			RequireAlias(true),
+		bulk.Create(map[string]any{"name": "case"}).Index("products").ID("2"),
+	)
+
+	// When
+	body := ndjson(t, request)
+
+	// Then
+	assert.Equal(t,
+		"{\"index\":{\"_id\":\"1\",\"_index\":\"products\",\"pipeline\":\"enrich\",\"require_alias\":true,\"routing\":\"user-1\"}}\n"+
+			"{\"name\":\"phone\",\"stock\":3}\n"+
+			"{\"create\":{\"_id\":\"2\",\"_index\":\"products\"}}\n"+
+			"{\"name\":\"case\"}\n",
+		body,
+	)
+}
+
+func Test_actions_should_write_concurrency_control_metadata(t *testing.T) {
+	t.Parallel()
+	// Given
+	request := bulk.NewRequest(
+		bulk.Delete("1").IfSeqNo(10).IfPrimaryTerm(2),
+		bulk.Index(product{}).ID("2").Version(7).VersionType(VersionType.External),
+	)
+
+	// When
+	body := ndjson(t, request)
+
+	// Then
+	assert.Equal(t,
+		"{\"delete\":{\"_id\":\"1\",\"if_primary_term\":2,\"if_seq_no\":10}}\n"+
+			"{\"index\":{\"_id\":\"2\",\"version\":7,\"version_type\":\"external\"}}\n"+
+			"{\"name\":\"\",\"stock\":0}\n",
+		body,
+	)
+}
+
+func Test_Update_should_write_partial_document_update(t *testing.T) {
+	t.Parallel()
+	// Given
+	request := bulk.NewRequest(
+		bulk.Update("1").Index("products").Doc(map[string]any{"stock": 0}).DocAsUpsert(true).DetectNoop(false).RetryOnConflict(3),
+	)
+
+	// When
+	body := ndjson(t, request)
+
+	// Then
+	assert.Equal(t,
+		"{\"update\":{\"_id\":\"1\",\"_index\":\"products\",\"retry_on_conflict\":3}}\n"+
+			"{\"detect_noop\":false,\"doc\":{\"stock\":0},\"doc_as_upsert\":true}\n",
+		body,
+	)
+}
+
+func Test_Update_should_write_scripted_update(t *testing.T) {
+	t.Parallel()
+	// Given
+	request := bulk.NewRequest(
+		bulk.Update("1").
+			Script(es.ScriptSource("ctx._source.stock -= params.n", ScriptLanguage.Painless).Parameter("n", 1)).
+			Upsert(product{Name: "phone"}).
+			ScriptedUpsert(true),
+	)
+
+	// When
+	body := ndjson(t, request)
+
+	// Then
+	assert.Equal(t,
+		"{\"update\":{\"_id\":\"1\"}}\n"+
+			"{\"script\":{\"lang\":\"painless\",\"params\":{\"n\":1},\"source\":\"ctx._source.stock -= params.n\"},\"scripted_upsert\":true,\"upsert\":{\"name\":\"phone\",\"stock\":0}}\n",
+		body,
+	)
+}
+
+func Test_update_options_should_fail_on_other_actions(t *testing.T) {
+	t.Parallel()
+	// Given
+	index := bulk.NewRequest(bulk.Index(product{Name: "phone"}).Doc(map[string]any{"stock": 1}))
+	deletion := bulk.NewRequest(bulk.Delete("2").DocAsUpsert(true).Script(es.ScriptID("decrement", ScriptLanguage.Painless)))
+	writer := bulk.NewWriter(func(_ []byte) error { return nil })
+
+	// When
+	_, indexErr := index.WriteTo(io.Discard)
+	_, deleteErr := deletion.WriteTo(io.Discard)
+	writerErr := writer.Add(bulk.Create(product{Name: "phone"}).Upsert(product{}))
+
+	// Then
+	assert.Equal(t, "bulk: \"doc\" can only be set on an update action, not on index", indexErr.Error())
+	assert.Equal(t, "bulk: \"doc_as_upsert\" can only be set on an update action, not on delete", deleteErr.Error())
+	assert.Equal(t, "bulk: \"upsert\" can only be set on an update action, not on create", writerErr.Error())
+	assert.Equal(t, 0, writer.Buffered())
+}
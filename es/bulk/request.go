@@ -0,0 +1,78 @@
+package bulk
+
+import (
+	"bytes"
+	"io"
+)
+
+type requestType []actionType
+
+// NewRequest creates a bulk request from the given actions.
+//
+// Example usage:
+//
+//	req := bulk.NewRequest(
+//		bulk.Index(product).Index("products").ID("1"),
+//		bulk.Delete("2").Index("products"),
+//	)
+//	var body bytes.Buffer
+//	_, err := req.WriteTo(&body)
+//
+// Parameters:
+//   - actions: The actions of the request, in order.
+//
+// Returns:
+//
+//	A bulk.requestType holding the actions.
+func NewRequest(actions ...actionType) requestType {
+	return requestType(actions)
+}
+
+// Add appends actions to the bulk request. The receiver is not modified, so
+// requests built from a shared prefix do not overwrite each other.
+//
+// Parameters:
+//   - actions: The actions to append.
+//
+// Returns:
+//
+//	The updated bulk.requestType.
+func (r requestType) Add(actions ...actionType) requestType {
+	request := make(requestType, len(r), len(r)+len(actions))
+	copy(request, r)
+	return append(request, actions...)
+}
+
+// Len returns the number of actions in the bulk request.
+//
+// Returns:
+//
+//	The number of actions.
+func (r requestType) Len() int {
+	return len(r)
+}
+
+// WriteTo writes the bulk request as newline-delimited JSON, so it implements io.WriterTo.
+//
+// Parameters:
+//   - w: The io.Writer the request is written to.
+//
+// Returns:
+//
+//	The number of bytes written and the first marshaling or write error.
+func (r requestType) WriteTo(w io.Writer) (int64, error) {
+	var total int64
+	var buf bytes.Buffer
+	for i := 0; i < len(r); i++ {
+		buf.Reset()
+		if err := r[i].appendTo(&buf); err != nil {
+			return total, err
+		}
+		n, err := w.Write(buf.Bytes())
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,83 @@
+package bulk_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/bulk"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   NewRequest   ////
+
+func Test_NewRequest_should_create_requestType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	request := bulk.NewRequest()
+
+	// Then
+	assert.IsTypeString(t, "bulk.requestType", request)
+	assert.Equal(t, 0, request.Len())
+	assert.Equal(t, "", ndjson(t, request))
+}
+
+func Test_NewRequest_Add_should_append_actions_in_order(t *testing.T) {
+	t.Parallel()
+	// Given
+	request := bulk.NewRequest(bulk.Delete("1")).Add(bulk.Delete("2"), bulk.Delete("3"))
+
+	// When
+	body := ndjson(t, request)
+
+	// Then
+	assert.Equal(t, 3, request.Len())
+	assert.Equal(t, "{\"delete\":{\"_id\":\"1\"}}\n{\"delete\":{\"_id\":\"2\"}}\n{\"delete\":{\"_id\":\"3\"}}\n", body)
+}
+
+func Test_NewRequest_Add_should_not_share_actions_between_requests(t *testing.T) {
+	t.Parallel()
+	// Given
+	prefix := bulk.NewRequest().Add(bulk.Delete("1")).Add(bulk.Delete("2")).Add(bulk.Delete("3"))
+
+	// When
+	first := prefix.Add(bulk.Delete("4"))
+	second := prefix.Add(bulk.Delete("5"))
+
+	// Then
+	assert.Equal(t, 3, prefix.Len())
+	// nolint:golint,lll
+	assert.Equal(t, "{\"delete\":{\"_id\":\"1\"}}\n{\"delete\":{\"_id\":\"2\"}}\n{\"delete\":{\"_id\":\"3\"}}\n{\"delete\":{\"_id\":\"4\"}}\n", ndjson(t, first))
+	// nolint:golint,lll
+	assert.Equal(t, "{\"delete\":{\"_id\":\"1\"}}\n{\"delete\":{\"_id\":\"2\"}}\n{\"delete\":{\"_id\":\"3\"}}\n{\"delete\":{\"_id\":\"5\"}}\n", ndjson(t, second))
+}
+
+type limitedWriter struct {
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		return 0, errors.New("short write")
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+func Test_NewRequest_WriteTo_should_return_write_and_marshal_errors(t *testing.T) {
+	t.Parallel()
+	// Given
+	request := bulk.NewRequest(bulk.Delete("1"), bulk.Delete("2"))
+	invalid := bulk.NewRequest(bulk.Delete("1"), bulk.Index(func() {}))
+	var buf bytes.Buffer
+
+	// When
+	n, writeErr := request.WriteTo(&limitedWriter{limit: 24})
+	_, marshalErr := invalid.WriteTo(&buf)
+
+	// Then
+	assert.Equal(t, "short write", writeErr.Error())
+	assert.Equal(t, int64(23), n)
+	assert.NotNil(t, marshalErr)
+	assert.Equal(t, "{\"delete\":{\"_id\":\"1\"}}\n", buf.String())
+}
@@ -0,0 +1,172 @@
+package bulk
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Trendyol/es-query-builder/es/response"
+)
+
+// Response is the response of the _bulk API, with one item per action in the
+// order the actions were sent.
+type Response struct {
+	Items  []Item `json:"items"`
+	Took   int64  `json:"took"`
+	Errors bool   `json:"errors"`
+}
+
+// Item is the result of a single bulk action.
+type Item struct {
+	// Error holds the cause of a failed action and is nil for successful ones.
+	Error *response.ErrorCause `json:"error,omitempty"`
+	// Action is the name of the action: "index", "create", "update" or "delete".
+	Action string `json:"-"`
+	Index  string `json:"_index"`
+	ID     string `json:"_id"`
+	// Result is "created", "updated", "deleted", "not_found" or "noop" for successful actions.
+	Result      string `json:"result"`
+	Version     int64  `json:"_version"`
+	SeqNo       int64  `json:"_seq_no"`
+	PrimaryTerm int64  `json:"_primary_term"`
+	Status      int    `json:"status"`
+}
+
+// UnmarshalJSON decodes an item of the form {"<action>": {...}}.
+func (i *Item) UnmarshalJSON(data []byte) error {
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return err
+	}
+	if len(wrapper) != 1 {
+		return fmt.Errorf("bulk response item must have a single action, got %d", len(wrapper))
+	}
+	type plainItem Item
+	for action, body := range wrapper {
+		var item plainItem
+		if err := json.Unmarshal(body, &item); err != nil {
+			return err
+		}
+		item.Action = action
+		*i = Item(item)
+	}
+	return nil
+}
+
+// ItemError is a failed bulk action together with its position in the request.
+type ItemError struct {
+	Item     Item
+	Position int
+}
+
+// Error formats the failure as "<action> [<position>] _id=<id>: <status> <type>: <reason>".
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("%s [%d] _id=%s: %d %s", e.Item.Action, e.Position, e.Item.ID, e.Item.Status, e.Item.Error.Error())
+}
+
+// Unwrap returns the es/response.ErrorCause of the failed action.
+func (e *ItemError) Unwrap() error {
+	return e.Item.Error
+}
+
+// Error is returned by bulk.Response.Err when at least one action failed.
+type Error struct {
+	Items []*ItemError
+}
+
+// Error summarizes the failed actions, listing the first of them.
+func (e *Error) Error() string {
+	const listed = 3
+	messages := make([]string, 0, listed)
+	for i := 0; i < len(e.Items) && i < listed; i++ {
+		messages = append(messages, e.Items[i].Error())
+	}
+	summary := fmt.Sprintf("bulk: %d actions failed: %s", len(e.Items), strings.Join(messages, "; "))
+	if len(e.Items) > listed {
+		summary += "; ..."
+	}
+	return summary
+}
+
+// DecodeResponse reads a bulk response from the given reader.
+//
+// Example usage:
+//
+//	res, err := client.Bulk(&body)
+//	if err != nil {
+//		return err
+//	}
+//	defer res.Body.Close()
+//	bulkResponse, err := bulk.DecodeResponse(res.Body)
+//
+// Parameters:
+//   - reader: An io.Reader providing the raw JSON bulk response.
+//
+// Returns:
+//
+//	A pointer to the decoded bulk.Response, or an error if decoding fails.
+func DecodeResponse(reader io.Reader) (*Response, error) {
+	var bulkResponse Response
+	if err := json.NewDecoder(reader).Decode(&bulkResponse); err != nil {
+		return nil, err
+	}
+	return &bulkResponse, nil
+}
+
+// UnmarshalResponse decodes a raw JSON bulk response.
+//
+// Parameters:
+//   - data: The raw JSON bulk response.
+//
+// Returns:
+//
+//	A pointer to the decoded bulk.Response, or an error if decoding fails.
+func UnmarshalResponse(data []byte) (*Response, error) {
+	var bulkResponse Response
+	if err := json.Unmarshal(data, &bulkResponse); err != nil {
+		return nil, err
+	}
+	return &bulkResponse, nil
+}
+
+// Failed returns the failed actions in request order.
+//
+// Example usage:
+//
+//	for _, failed := range bulkResponse.Failed() {
+//		log.Printf("retrying %s: %v", failed.Item.ID, failed)
+//	}
+//
+// Returns:
+//
+//	A bulk.ItemError for every item with an error, or nil when all actions succeeded.
+func (r *Response) Failed() []*ItemError {
+	var failed []*ItemError
+	for i := 0; i < len(r.Items); i++ {
+		if r.Items[i].Error != nil {
+			failed = append(failed, &ItemError{Item: r.Items[i], Position: i})
+		}
+	}
+	return failed
+}
+
+// Err returns a *bulk.Error listing the failed actions, or nil when all actions succeeded.
+//
+// Example usage:
+//
+//	if err := bulkResponse.Err(); err != nil {
+//		var bulkErr *bulk.Error
+//		errors.As(err, &bulkErr)
+//	}
+//
+// Returns:
+//
+//	A *bulk.Error when at least one action failed, nil otherwise.
+func (r *Response) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	return &Error{Items: failed}
+}
@@ -0,0 +1,121 @@
+package bulk_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/bulk"
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+// nolint:golint,lll
+const bulkResponseJSON = `{
+  "took": 30,
+  "errors": true,
+  "items": [
+    {"index": {"_index": "products", "_id": "1", "_version": 1, "result": "created", "_seq_no": 0, "_primary_term": 1, "status": 201}},
+    {"create": {"_index": "products", "_id": "2", "status": 409, "error": {"type": "version_conflict_engine_exception", "reason": "[2]: version conflict, document already exists"}}},
+    {"update": {"_index": "products", "_id": "3", "status": 404, "error": {"type": "document_missing_exception", "reason": "[3]: document missing"}}},
+    {"delete": {"_index": "products", "_id": "4", "_version": 2, "result": "not_found", "status": 404}}
+  ]
+}`
+
+////   Response   ////
+
+func Test_UnmarshalResponse_should_decode_items_in_order(t *testing.T) {
+	t.Parallel()
+	// Given When
+	bulkResponse, err := bulk.UnmarshalResponse([]byte(bulkResponseJSON))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(30), bulkResponse.Took)
+	assert.True(t, bulkResponse.Errors)
+	assert.Equal(t, 4, len(bulkResponse.Items))
+	first := bulkResponse.Items[0]
+	assert.Equal(t, "index", first.Action)
+	assert.Equal(t, "created", first.Result)
+	assert.Equal(t, int64(1), first.PrimaryTerm)
+	assert.Equal(t, 201, first.Status)
+	assert.True(t, first.Error == nil)
+	assert.Equal(t, "delete", bulkResponse.Items[3].Action)
+	assert.Equal(t, "not_found", bulkResponse.Items[3].Result)
+}
+
+func Test_Response_Failed_should_return_failed_items_with_positions(t *testing.T) {
+	t.Parallel()
+	// Given
+	bulkResponse, err := bulk.DecodeResponse(strings.NewReader(bulkResponseJSON))
+	assert.Nil(t, err)
+
+	// When
+	failed := bulkResponse.Failed()
+
+	// Then
+	assert.Equal(t, 2, len(failed))
+	assert.Equal(t, 1, failed[0].Position)
+	assert.Equal(t, "create [1] _id=2: 409 version_conflict_engine_exception: [2]: version conflict, document already exists", failed[0].Error())
+	assert.Equal(t, 2, failed[1].Position)
+	var cause *response.ErrorCause
+	assert.True(t, errors.As(failed[1], &cause))
+	assert.Equal(t, "document_missing_exception", cause.Type)
+}
+
+func Test_Response_Err_should_summarize_failures(t *testing.T) {
+	t.Parallel()
+	// Given
+	bulkResponse, err := bulk.UnmarshalResponse([]byte(bulkResponseJSON))
+	assert.Nil(t, err)
+	succeeded, err := bulk.UnmarshalResponse([]byte(`{"took": 1, "errors": false, "items": [{"delete": {"_id": "1", "status": 200}}]}`))
+	assert.Nil(t, err)
+
+	// When
+	bulkErr := bulkResponse.Err()
+
+	// Then
+	assert.True(t, succeeded.Err() == nil)
+	var typed *bulk.Error
+	assert.True(t, errors.As(bulkErr, &typed))
+	assert.Equal(t, 2, len(typed.Items))
+	assert.Equal(t,
+		"bulk: 2 actions failed: create [1] _id=2: 409 version_conflict_engine_exception: [2]: version conflict, document already exists; "+
+			"update [2] _id=3: 404 document_missing_exception: [3]: document missing",
+		bulkErr.Error(),
+	)
+}
+
+func Test_Error_should_list_first_three_failures(t *testing.T) {
+	t.Parallel()
+	// Given
+	cause := &response.ErrorCause{Type: "t", Reason: "r"}
+	items := make([]*bulk.ItemError, 0, 4)
+	for i := 0; i < 4; i++ {
+		items = append(items, &bulk.ItemError{Item: bulk.Item{Action: "index", ID: "x", Status: 400, Error: cause}, Position: i})
+	}
+
+	// When
+	message := (&bulk.Error{Items: items}).Error()
+
+	// Then
+	assert.Equal(t,
+		"bulk: 4 actions failed: index [0] _id=x: 400 t: r; index [1] _id=x: 400 t: r; index [2] _id=x: 400 t: r; ...",
+		message,
+	)
+}
+
+func Test_DecodeResponse_should_return_error_for_invalid_items(t *testing.T) {
+	t.Parallel()
+	// Given When
+	_, invalidErr := bulk.DecodeResponse(strings.NewReader(`{"items":`))
+	_, multipleErr := bulk.UnmarshalResponse([]byte(`{"items": [{"index": {}, "delete": {}}]}`))
+	_, bodyErr := bulk.UnmarshalResponse([]byte(`{"items": [{"index": []}]}`))
+	_, itemErr := bulk.UnmarshalResponse([]byte(`{"items": [1]}`))
+
+	// Then
+	assert.NotNil(t, invalidErr)
+	assert.Equal(t, "bulk response item must have a single action, got 2", multipleErr.Error())
+	assert.NotNil(t, bodyErr)
+	assert.NotNil(t, itemErr)
+}
@@ -0,0 +1,152 @@
+package bulk
+
+import (
+	"bytes"
+)
+
+const (
+	// DefaultFlushActions is the number of buffered actions that triggers a flush by default.
+	DefaultFlushActions = 1000
+
+	// DefaultFlushBytes is the body size in bytes that triggers a flush by default.
+	DefaultFlushBytes = 5 << 20
+)
+
+// FlushFunc sends a bulk request body, for example with the Bulk API of the
+// official client. The body is reused after the call returns and must not be retained.
+type FlushFunc func(body []byte) error
+
+// Writer buffers actions as newline-delimited JSON and passes the buffered body
+// to a FlushFunc whenever it reaches a number of actions or a size in bytes.
+type Writer struct {
+	flush        FlushFunc
+	buf          bytes.Buffer
+	scratch      bytes.Buffer
+	flushActions int
+	flushBytes   int
+	actions      int
+}
+
+// NewWriter creates a bulk.Writer that flushes every DefaultFlushActions actions
+// or DefaultFlushBytes bytes, whichever comes first.
+//
+// Example usage:
+//
+//	writer := bulk.NewWriter(func(body []byte) error {
+//		res, err := client.Bulk(bytes.NewReader(body), client.Bulk.WithIndex("products"))
+//		if err != nil {
+//			return err
+//		}
+//		defer res.Body.Close()
+//		bulkResponse, err := bulk.DecodeResponse(res.Body)
+//		if err != nil {
+//			return err
+//		}
+//		return bulkResponse.Err()
+//	}).FlushActions(500)
+//
+//	for _, product := range products {
+//		if err := writer.Add(bulk.Index(product).ID(product.ID)); err != nil {
+//			return err
+//		}
+//	}
+//	return writer.Flush()
+//
+// Parameters:
+//   - flush: The bulk.FlushFunc that sends each body.
+//
+// Returns:
+//
+//	A new *bulk.Writer.
+func NewWriter(flush FlushFunc) *Writer {
+	return &Writer{
+		flush:        flush,
+		flushActions: DefaultFlushActions,
+		flushBytes:   DefaultFlushBytes,
+	}
+}
+
+// FlushActions sets the number of buffered actions that triggers a flush. Zero
+// or a negative value disables flushing by count.
+//
+// Parameters:
+//   - actions: The maximum number of actions in a body.
+//
+// Returns:
+//
+//	The *bulk.Writer.
+func (w *Writer) FlushActions(actions int) *Writer {
+	w.flushActions = actions
+	return w
+}
+
+// FlushBytes sets the body size that is not exceeded by adding another action.
+// A single action larger than the limit is sent alone. Zero or a negative value
+// disables flushing by size.
+//
+// Parameters:
+//   - bytes: The maximum body size in bytes.
+//
+// Returns:
+//
+//	The *bulk.Writer.
+func (w *Writer) FlushBytes(bytes int) *Writer {
+	w.flushBytes = bytes
+	return w
+}
+
+// Add buffers actions and flushes whenever a limit is reached.
+//
+// Parameters:
+//   - actions: The actions to add, in order.
+//
+// Returns:
+//
+//	The first marshaling or flush error. Actions after a failing one are not added.
+func (w *Writer) Add(actions ...actionType) error {
+	for i := 0; i < len(actions); i++ {
+		w.scratch.Reset()
+		if err := actions[i].appendTo(&w.scratch); err != nil {
+			return err
+		}
+		if w.flushBytes > 0 && w.actions > 0 && w.buf.Len()+w.scratch.Len() > w.flushBytes {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+		w.buf.Write(w.scratch.Bytes())
+		w.actions++
+		if w.flushActions > 0 && w.actions >= w.flushActions {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Flush sends the buffered actions, if any. The buffer is emptied even when
+// the bulk.FlushFunc fails, so a failed body is not sent twice.
+//
+// Returns:
+//
+//	The error returned by the bulk.FlushFunc.
+func (w *Writer) Flush() error {
+	if w.actions == 0 {
+		return nil
+	}
+	defer func() {
+		w.buf.Reset()
+		w.actions = 0
+	}()
+	return w.flush(w.buf.Bytes())
+}
+
+// Buffered returns the number of actions waiting for the next flush.
+//
+// Returns:
+//
+//	The number of buffered actions.
+func (w *Writer) Buffered() int {
+	return w.actions
+}
@@ -0,0 +1,91 @@
+package bulk_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/bulk"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+type recorder struct {
+	err    error
+	bodies []string
+}
+
+func (r *recorder) flush(body []byte) error {
+	r.bodies = append(r.bodies, string(body))
+	return r.err
+}
+
+////   Writer   ////
+
+func Test_Writer_should_flush_by_action_count(t *testing.T) {
+	t.Parallel()
+	// Given
+	rec := &recorder{}
+	writer := bulk.NewWriter(rec.flush).FlushActions(2)
+
+	// When
+	err := writer.Add(bulk.Delete("1"), bulk.Delete("2"), bulk.Delete("3"))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"{\"delete\":{\"_id\":\"1\"}}\n{\"delete\":{\"_id\":\"2\"}}\n"}, rec.bodies)
+	assert.Equal(t, 1, writer.Buffered())
+	assert.Nil(t, writer.Flush())
+	assert.Equal(t, "{\"delete\":{\"_id\":\"3\"}}\n", rec.bodies[1])
+	assert.Equal(t, 0, writer.Buffered())
+}
+
+func Test_Writer_should_flush_before_exceeding_size(t *testing.T) {
+	t.Parallel()
+	// Given
+	rec := &recorder{}
+	line := len("{\"delete\":{\"_id\":\"1\"}}\n")
+	writer := bulk.NewWriter(rec.flush).FlushActions(0).FlushBytes(2*line + 1)
+
+	// When
+	err := writer.Add(bulk.Delete("1"), bulk.Delete("2"), bulk.Delete("3"), bulk.Index(strings.Repeat("x", 100)))
+	flushErr := writer.Flush()
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, flushErr)
+	assert.Equal(t, 3, len(rec.bodies))
+	assert.Equal(t, 2*line, len(rec.bodies[0]))
+	assert.Equal(t, "{\"delete\":{\"_id\":\"3\"}}\n", rec.bodies[1])
+	assert.True(t, strings.HasPrefix(rec.bodies[2], "{\"index\":{}}\n\"xxx"))
+}
+
+func Test_Writer_Flush_should_do_nothing_when_empty(t *testing.T) {
+	t.Parallel()
+	// Given
+	rec := &recorder{}
+	writer := bulk.NewWriter(rec.flush)
+
+	// When
+	err := writer.Flush()
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(rec.bodies))
+}
+
+func Test_Writer_should_return_flush_and_marshal_errors(t *testing.T) {
+	t.Parallel()
+	// Given
+	rec := &recorder{err: errors.New("cluster unavailable")}
+	writer := bulk.NewWriter(rec.flush).FlushActions(1)
+
+	// When
+	flushErr := writer.Add(bulk.Delete("1"), bulk.Delete("2"))
+	marshalErr := writer.Add(bulk.Index(make(chan int)))
+
+	// Then
+	assert.Equal(t, "cluster unavailable", flushErr.Error())
+	assert.Equal(t, 1, len(rec.bodies))
+	assert.Equal(t, 0, writer.Buffered())
+	assert.NotNil(t, marshalErr)
+}
@@ -0,0 +1,68 @@
+package es
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// ByQueryParams holds the URL parameters of _update_by_query and _delete_by_query
+// requests. Zero values are left out, so only the parameters that are set end up
+// in the query string.
+//
+// Example usage:
+//
+//	wait := false
+//	params := es.ByQueryParams{Refresh: true, WaitForCompletion: &wait, RequestsPerSecond: 500, Slices: "auto"}
+//	path := "/products/_delete_by_query?" + params.Encode()
+//	// path is "/products/_delete_by_query?refresh=true&requests_per_second=500&slices=auto&wait_for_completion=false"
+type ByQueryParams struct {
+	// WaitForCompletion runs the request as a task and returns its id right away when set to false.
+	WaitForCompletion *bool
+	// Slices splits the request into this many slices, or lets Elasticsearch pick with "auto".
+	Slices string
+	// Scroll is how long the search context of the request is kept alive, such as "5m".
+	Scroll string
+	// Timeout is how long each write waits for unavailable shards, such as "1m".
+	Timeout string
+	// RequestsPerSecond throttles the request; -1 disables throttling.
+	RequestsPerSecond float64
+	// Refresh refreshes the affected shards once the request completes.
+	Refresh bool
+}
+
+// Values returns the parameters that are set as url.Values.
+//
+// Returns:
+//
+//	The url.Values holding the set parameters.
+func (p ByQueryParams) Values() url.Values {
+	values := url.Values{}
+	if p.Refresh {
+		values.Set("refresh", "true")
+	}
+	if p.WaitForCompletion != nil {
+		values.Set("wait_for_completion", strconv.FormatBool(*p.WaitForCompletion))
+	}
+	if p.RequestsPerSecond != 0 {
+		values.Set("requests_per_second", strconv.FormatFloat(p.RequestsPerSecond, 'f', -1, 64))
+	}
+	if p.Slices != "" {
+		values.Set("slices", p.Slices)
+	}
+	if p.Scroll != "" {
+		values.Set("scroll", p.Scroll)
+	}
+	if p.Timeout != "" {
+		values.Set("timeout", p.Timeout)
+	}
+	return values
+}
+
+// Encode returns the parameters that are set as a URL query string sorted by key.
+//
+// Returns:
+//
+//	The encoded query string, without the leading "?".
+func (p ByQueryParams) Encode() string {
+	return p.Values().Encode()
+}
@@ -0,0 +1,57 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   ByQueryParams   ////
+
+func Test_ByQueryParams_should_encode_set_parameters(t *testing.T) {
+	t.Parallel()
+	// Given
+	wait := false
+	params := es.ByQueryParams{
+		WaitForCompletion: &wait,
+		Slices:            "auto",
+		Scroll:            "5m",
+		Timeout:           "1m",
+		RequestsPerSecond: 12.5,
+		Refresh:           true,
+	}
+
+	// When
+	encoded := params.Encode()
+
+	// Then
+	assert.Equal(t, "refresh=true&requests_per_second=12.5&scroll=5m&slices=auto&timeout=1m&wait_for_completion=false", encoded)
+}
+
+func Test_ByQueryParams_should_omit_zero_values(t *testing.T) {
+	t.Parallel()
+	// Given
+	params := es.ByQueryParams{}
+
+	// When
+	values := params.Values()
+
+	// Then
+	assert.Equal(t, 0, len(values))
+	assert.Equal(t, "", params.Encode())
+}
+
+func Test_ByQueryParams_should_encode_unthrottled_requests(t *testing.T) {
+	t.Parallel()
+	// Given
+	wait := true
+	params := es.ByQueryParams{WaitForCompletion: &wait, RequestsPerSecond: -1}
+
+	// When
+	values := params.Values()
+
+	// Then
+	assert.Equal(t, "-1", values.Get("requests_per_second"))
+	assert.Equal(t, "true", values.Get("wait_for_completion"))
+}
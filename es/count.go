@@ -0,0 +1,74 @@
+package es
+
+import "sort"
+
+type countType Object
+
+// NewCount creates the body of a _count request. The _count API only accepts a
+// query, so the same query clauses used for searches can be reused for counts.
+//
+// A search body created with es.NewQuery is accepted as well, in which case
+// only its "query" is kept and keys such as "size", "sort" or "aggs" are dropped.
+// Use es.CountFromSearch to get an error for those keys instead.
+//
+// Example usage:
+//
+//	filter := es.Bool().Filter(es.Term("brand", "apple"))
+//	search := es.NewQuery(filter).Size(20)
+//	count := es.NewCount(filter)
+//	// count now contains {"query": {"bool": {"filter": [{"term": {"brand": {"value": "apple"}}}]}}}
+//
+// Parameters:
+//   - queryClause: The query clause, or an es.Object created with es.NewQuery.
+//
+// Returns:
+//
+//	An es.countType object containing the "query" field.
+func NewCount(queryClause any) countType {
+	if object, ok := queryClause.(Object); ok {
+		if query, exists := object["query"]; exists {
+			queryClause = query
+		}
+	}
+	if field, ok := correctType(queryClause); ok {
+		return countType{
+			"query": field,
+		}
+	}
+	return countType{
+		"query": Object{},
+	}
+}
+
+// CountFromSearch converts a search body into the body of a _count request and
+// reports every key the _count API would reject.
+//
+// Example usage:
+//
+//	count, err := es.CountFromSearch(es.NewQuery(es.MatchAll()).Size(10))
+//	// err.Error() == "size: not allowed in a count body"
+//
+// Parameters:
+//   - search: The es.Object search body to convert.
+//
+// Returns:
+//
+//	The es.countType holding the query of the search body, and es.ValidationErrors
+//	with one entry per disallowed key, in key order, or nil when there is none.
+func CountFromSearch(search Object) (countType, error) {
+	var disallowed []string
+	for key := range search {
+		if key != "query" {
+			disallowed = append(disallowed, key)
+		}
+	}
+	if len(disallowed) == 0 {
+		return NewCount(search), nil
+	}
+	sort.Strings(disallowed)
+	errs := make(ValidationErrors, 0, len(disallowed))
+	for i := 0; i < len(disallowed); i++ {
+		errs = append(errs, &ValidationError{Path: disallowed[i], Message: "not allowed in a count body"})
+	}
+	return nil, errs
+}
@@ -0,0 +1,102 @@
+package es_test
+
+import (
+	"errors"
+	"testing"
+
+	Order "github.com/Trendyol/es-query-builder/es/enums/sort/order"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   NewCount   ////
+
+func Test_NewCount_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.NewCount)
+	assert.NotNil(t, es.CountFromSearch)
+}
+
+func Test_NewCount_should_create_countType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	count := es.NewCount(es.Term("brand", "apple"))
+
+	// Then
+	assert.IsTypeString(t, "es.countType", count)
+	bodyJSON := assert.MarshalWithoutError(t, count)
+	assert.Equal(t, "{\"query\":{\"term\":{\"brand\":{\"value\":\"apple\"}}}}", bodyJSON)
+}
+
+func Test_NewCount_should_reuse_bool_query_of_search(t *testing.T) {
+	t.Parallel()
+	// Given
+	filter := es.Bool().Filter(es.Term("brand", "apple"))
+	search := es.NewQuery(filter).Size(20)
+
+	// When
+	count := es.NewCount(filter)
+
+	// Then
+	searchJSON := assert.MarshalWithoutError(t, search)
+	countJSON := assert.MarshalWithoutError(t, count)
+	assert.Equal(t, "{\"query\":{\"bool\":{\"filter\":[{\"term\":{\"brand\":{\"value\":\"apple\"}}}]}},\"size\":20}", searchJSON)
+	assert.Equal(t, "{\"query\":{\"bool\":{\"filter\":[{\"term\":{\"brand\":{\"value\":\"apple\"}}}]}}}", countJSON)
+}
+
+func Test_NewCount_should_strip_search_only_keys(t *testing.T) {
+	t.Parallel()
+	// Given
+	search := es.NewQuery(es.MatchAll()).Size(10).From(20).Sort(es.Sort("date").Order(Order.Desc))
+
+	// When
+	count := es.NewCount(search)
+
+	// Then
+	bodyJSON := assert.MarshalWithoutError(t, count)
+	assert.Equal(t, "{\"query\":{\"match_all\":{}}}", bodyJSON)
+}
+
+func Test_NewCount_should_create_empty_query_for_nil(t *testing.T) {
+	t.Parallel()
+	// Given When
+	count := es.NewCount(nil)
+
+	// Then
+	bodyJSON := assert.MarshalWithoutError(t, count)
+	assert.Equal(t, "{\"query\":{}}", bodyJSON)
+}
+
+////   CountFromSearch   ////
+
+func Test_CountFromSearch_should_convert_query_only_search(t *testing.T) {
+	t.Parallel()
+	// Given
+	search := es.NewQuery(es.Exists("price"))
+
+	// When
+	count, err := es.CountFromSearch(search)
+
+	// Then
+	assert.Nil(t, err)
+	bodyJSON := assert.MarshalWithoutError(t, count)
+	assert.Equal(t, "{\"query\":{\"exists\":{\"field\":\"price\"}}}", bodyJSON)
+}
+
+func Test_CountFromSearch_should_return_error_for_disallowed_keys(t *testing.T) {
+	t.Parallel()
+	// Given
+	search := es.NewQuery(es.MatchAll()).Size(10).Sort(es.Sort("date")).Aggs(es.Agg("brands", es.TermsAgg("brand")))
+
+	// When
+	count, err := es.CountFromSearch(search)
+
+	// Then
+	assert.True(t, count == nil)
+	var errs es.ValidationErrors
+	assert.True(t, errors.As(err, &errs))
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, "aggs: not allowed in a count body; size: not allowed in a count body; sort: not allowed in a count body", err.Error())
+}
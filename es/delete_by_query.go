@@ -0,0 +1,79 @@
+package es
+
+import Conflicts "github.com/Trendyol/es-query-builder/es/enums/conflicts"
+
+type deleteByQueryType Object
+
+// DeleteByQuery creates the body of a _delete_by_query request, which deletes
+// every document matching the query.
+//
+// Example usage:
+//
+//	body := es.DeleteByQuery(es.Range("created_at").LessThan("now-30d")).
+//		Conflicts(Conflicts.Proceed).
+//		MaxDocs(10_000)
+//	// body now contains the "query", "conflicts" and "max_docs" fields.
+//
+// Parameters:
+//   - queryClause: The query selecting the documents to delete. Elasticsearch
+//     requires a query, so a nil query clause is omitted and rejected by the server.
+//
+// Returns:
+//
+//	An es.deleteByQueryType object with the "query" field set.
+func DeleteByQuery(queryClause any) deleteByQueryType {
+	d := deleteByQueryType{}
+	if field, ok := correctType(queryClause); ok {
+		d["query"] = field
+	}
+	return d
+}
+
+// MaxDocs sets the maximum number of documents the request processes.
+//
+// Parameters:
+//   - maxDocs: The maximum number of documents to delete.
+//
+// Returns:
+//
+//	The updated es.deleteByQueryType object with the "max_docs" field set.
+func (d deleteByQueryType) MaxDocs(maxDocs int) deleteByQueryType {
+	d["max_docs"] = maxDocs
+	return d
+}
+
+// Conflicts sets whether the request aborts or proceeds on version conflicts.
+//
+// Parameters:
+//   - conflicts: A Conflicts.Conflicts value.
+//
+// Returns:
+//
+//	The updated es.deleteByQueryType object with the "conflicts" field set.
+func (d deleteByQueryType) Conflicts(conflicts Conflicts.Conflicts) deleteByQueryType {
+	d["conflicts"] = conflicts
+	return d
+}
+
+// Slice makes the request process a single slice of the matching documents, so
+// a job can split the work across several manually sliced requests.
+//
+// Example usage:
+//
+//	body := es.DeleteByQuery(es.Term("tenant", "t-1")).Slice(1, 4)
+//	// body now contains the query and {"slice": {"id": 1, "max": 4}}
+//
+// Parameters:
+//   - id: The zero based id of the slice.
+//   - maxSlices: The total number of slices.
+//
+// Returns:
+//
+//	The updated es.deleteByQueryType object with the "slice" field set.
+func (d deleteByQueryType) Slice(id, maxSlices int) deleteByQueryType {
+	d["slice"] = Object{
+		"id":  id,
+		"max": maxSlices,
+	}
+	return d
+}
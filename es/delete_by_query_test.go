@@ -0,0 +1,66 @@
+package es_test
+
+import (
+	"testing"
+
+	Conflicts "github.com/Trendyol/es-query-builder/es/enums/conflicts"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   DeleteByQuery   ////
+
+func Test_DeleteByQuery_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.DeleteByQuery)
+}
+
+func Test_DeleteByQuery_should_create_deleteByQueryType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	body := es.DeleteByQuery(es.Range("created_at").LessThan("now-30d"))
+
+	// Then
+	assert.IsTypeString(t, "es.deleteByQueryType", body)
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	assert.Equal(t, "{\"query\":{\"range\":{\"created_at\":{\"lt\":\"now-30d\"}}}}", bodyJSON)
+}
+
+func Test_DeleteByQuery_should_omit_nil_query(t *testing.T) {
+	t.Parallel()
+	// Given When
+	body := es.DeleteByQuery(nil)
+
+	// Then
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	assert.Equal(t, "{}", bodyJSON)
+}
+
+func Test_DeleteByQuery_should_create_json_with_all_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := es.DeleteByQuery(es.Term("tenant", "t-1")).
+		MaxDocs(10000).
+		Conflicts(Conflicts.Proceed).
+		Slice(1, 4)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"conflicts\":\"proceed\",\"max_docs\":10000,\"query\":{\"term\":{\"tenant\":{\"value\":\"t-1\"}}},\"slice\":{\"id\":1,\"max\":4}}", bodyJSON)
+}
+
+func Test_DeleteByQuery_should_convert_to_Object(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := es.DeleteByQuery(es.IDs("1", "2")).Conflicts(Conflicts.Abort)
+
+	// When
+	object := es.Object(body)
+
+	// Then
+	assert.Equal(t, Conflicts.Abort, object["conflicts"])
+	assert.NotNil(t, object["query"])
+}
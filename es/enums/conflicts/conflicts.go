@@ -0,0 +1,29 @@
+package conflicts
+
+// Conflicts represents what an update by query or delete by query request does
+// when a document changes between the search and the write.
+//
+// Conflicts is a string type used to set the "conflicts" of by query requests.
+//
+// Example usage:
+//
+//	var c Conflicts = Proceed
+//
+//	// Use c in an es.DeleteByQuery(...).Conflicts(...) call
+//
+// Constants:
+//   - Abort: Stops the request at the first version conflict.
+//   - Proceed: Counts version conflicts and continues with the next documents.
+type Conflicts string
+
+const (
+	// Abort indicates that the request stops at the first version conflict, the default.
+	Abort Conflicts = "abort"
+
+	// Proceed indicates that version conflicts are counted and skipped.
+	Proceed Conflicts = "proceed"
+)
+
+func (conflicts Conflicts) String() string {
+	return string(conflicts)
+}
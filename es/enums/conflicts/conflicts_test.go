@@ -0,0 +1,25 @@
+package conflicts_test
+
+import (
+	"testing"
+
+	Conflicts "github.com/Trendyol/es-query-builder/es/enums/conflicts"
+
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+func Test_ConflictsString(t *testing.T) {
+	tests := []struct {
+		conflicts Conflicts.Conflicts
+		result    string
+	}{
+		{Conflicts.Abort, "abort"},
+		{Conflicts.Proceed, "proceed"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.result, func(t *testing.T) {
+			assert.Equal(t, test.result, test.conflicts.String())
+		})
+	}
+}
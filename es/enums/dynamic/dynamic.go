@@ -0,0 +1,37 @@
+package dynamic
+
+// Dynamic represents how an index mapping handles fields that are not mapped.
+//
+// Dynamic is a string type used to set the "dynamic" parameter of index mappings
+// and object fields.
+//
+// Example usage:
+//
+//	var d Dynamic = Strict
+//
+//	// Use d in a mapping.Mappings().Dynamic(...) call
+//
+// Constants:
+//   - True: New fields are added to the mapping.
+//   - False: New fields are ignored but kept in _source.
+//   - Strict: Documents with new fields are rejected.
+//   - Runtime: New fields are added to the mapping as runtime fields.
+type Dynamic string
+
+const (
+	// True indicates that new fields are added to the mapping.
+	True Dynamic = "true"
+
+	// False indicates that new fields are not indexed but kept in _source.
+	False Dynamic = "false"
+
+	// Strict indicates that documents with unmapped fields are rejected.
+	Strict Dynamic = "strict"
+
+	// Runtime indicates that new fields are added as runtime fields.
+	Runtime Dynamic = "runtime"
+)
+
+func (dynamic Dynamic) String() string {
+	return string(dynamic)
+}
@@ -0,0 +1,27 @@
+package dynamic_test
+
+import (
+	"testing"
+
+	Dynamic "github.com/Trendyol/es-query-builder/es/enums/dynamic"
+
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+func Test_DynamicString(t *testing.T) {
+	tests := []struct {
+		dynamic Dynamic.Dynamic
+		result  string
+	}{
+		{Dynamic.True, "true"},
+		{Dynamic.False, "false"},
+		{Dynamic.Strict, "strict"},
+		{Dynamic.Runtime, "runtime"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.result, func(t *testing.T) {
+			assert.Equal(t, test.result, test.dynamic.String())
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package optype
+
+// OpType represents how a write treats a document whose _id already exists.
+//
+// OpType is a string type used to set the "op_type" of reindex destinations.
+//
+// Example usage:
+//
+//	var o OpType = Create
+//
+//	// Use o in an es.ReindexDest(...).OpType(...) call
+//
+// Constants:
+//   - Index: Replaces existing documents.
+//   - Create: Only creates missing documents and reports a conflict for existing ones.
+type OpType string
+
+const (
+	// Index indicates that existing documents are replaced, the default.
+	Index OpType = "index"
+
+	// Create indicates that only missing documents are created.
+	Create OpType = "create"
+)
+
+func (opType OpType) String() string {
+	return string(opType)
+}
@@ -0,0 +1,25 @@
+package optype_test
+
+import (
+	"testing"
+
+	OpType "github.com/Trendyol/es-query-builder/es/enums/op-type"
+
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+func Test_OpTypeString(t *testing.T) {
+	tests := []struct {
+		opType OpType.OpType
+		result string
+	}{
+		{OpType.Index, "index"},
+		{OpType.Create, "create"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.result, func(t *testing.T) {
+			assert.Equal(t, test.result, test.opType.String())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package runtimefieldtype
+
+// RuntimeFieldType represents the types a runtime field can have.
+//
+// RuntimeFieldType is a string type used to set the "type" of runtime fields,
+// both in index mappings and in the runtime_mappings of search requests.
+//
+// Example usage:
+//
+//	var t RuntimeFieldType = Keyword
+//
+//	// Use t when defining a runtime field
+//
+// Constants:
+//   - Boolean: A boolean value.
+//   - Composite: A group of runtime fields emitted by a single script.
+//   - Date: A date value.
+//   - Double: A double precision floating point value.
+//   - GeoPoint: A latitude/longitude point.
+//   - IP: An IPv4 or IPv6 address.
+//   - Keyword: A keyword value.
+//   - Long: A 64-bit integer value.
+//   - Lookup: Values retrieved from another index.
+type RuntimeFieldType string
+
+const (
+	// Boolean indicates a runtime field of boolean values.
+	Boolean RuntimeFieldType = "boolean"
+
+	// Composite indicates a group of runtime fields emitted by one script.
+	Composite RuntimeFieldType = "composite"
+
+	// Date indicates a runtime field of date values.
+	Date RuntimeFieldType = "date"
+
+	// Double indicates a runtime field of double values.
+	Double RuntimeFieldType = "double"
+
+	// GeoPoint indicates a runtime field of geo_point values.
+	GeoPoint RuntimeFieldType = "geo_point"
+
+	// IP indicates a runtime field of IP addresses.
+	IP RuntimeFieldType = "ip"
+
+	// Keyword indicates a runtime field of keyword values.
+	Keyword RuntimeFieldType = "keyword"
+
+	// Long indicates a runtime field of long values.
+	Long RuntimeFieldType = "long"
+
+	// Lookup indicates a runtime field that retrieves values from another index.
+	Lookup RuntimeFieldType = "lookup"
+)
+
+func (runtimeFieldType RuntimeFieldType) String() string {
+	return string(runtimeFieldType)
+}
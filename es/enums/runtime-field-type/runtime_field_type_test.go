@@ -0,0 +1,32 @@
+package runtimefieldtype_test
+
+import (
+	"testing"
+
+	RuntimeFieldType "github.com/Trendyol/es-query-builder/es/enums/runtime-field-type"
+
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+func Test_RuntimeFieldTypeString(t *testing.T) {
+	tests := []struct {
+		fieldType RuntimeFieldType.RuntimeFieldType
+		result    string
+	}{
+		{RuntimeFieldType.Boolean, "boolean"},
+		{RuntimeFieldType.Composite, "composite"},
+		{RuntimeFieldType.Date, "date"},
+		{RuntimeFieldType.Double, "double"},
+		{RuntimeFieldType.GeoPoint, "geo_point"},
+		{RuntimeFieldType.IP, "ip"},
+		{RuntimeFieldType.Keyword, "keyword"},
+		{RuntimeFieldType.Long, "long"},
+		{RuntimeFieldType.Lookup, "lookup"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.result, func(t *testing.T) {
+			assert.Equal(t, test.result, test.fieldType.String())
+		})
+	}
+}
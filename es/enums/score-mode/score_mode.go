@@ -1,10 +1,11 @@
 package scoremode
 
-// ScoreMode represents the different scoring modes for nested queries.
+// ScoreMode represents the different scoring modes for nested queries and rescorers.
 //
 // ScoreMode is a string type used to specify how scores should be calculated and
 // combined for nested queries in search queries. It provides various options for
-// aggregating scores of nested documents.
+// aggregating scores of nested documents. Rescorers use it to combine the
+// original score with the rescore query score.
 //
 // Example usage:
 //
@@ -18,6 +19,8 @@ package scoremode
 //   - Min: Minimum score of the nested documents.
 //   - None: No scoring for the nested documents.
 //   - Sum: Sum of the scores of the nested documents.
+//   - Total: Sum of the original and rescore query scores.
+//   - Multiply: Product of the original and rescore query scores.
 type ScoreMode string
 
 const (
@@ -35,6 +38,12 @@ const (
 
 	// Sum indicates that the sum of the scores of nested documents should be used.
 	Sum ScoreMode = "sum"
+
+	// Total indicates that a rescorer adds the original and rescore query scores, its default.
+	Total ScoreMode = "total"
+
+	// Multiply indicates that a rescorer multiplies the original and rescore query scores.
+	Multiply ScoreMode = "multiply"
 )
 
 func (scoreMode ScoreMode) String() string {
@@ -18,6 +18,8 @@ func Test_ScoreModeString(t *testing.T) {
 		{ScoreMode.Min, "min"},
 		{ScoreMode.None, "none"},
 		{ScoreMode.Sum, "sum"},
+		{ScoreMode.Total, "total"},
+		{ScoreMode.Multiply, "multiply"},
 	}
 
 	for _, test := range tests {
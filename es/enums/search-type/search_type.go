@@ -0,0 +1,29 @@
+package searchtype
+
+// SearchType represents how a search request scores documents across shards.
+//
+// SearchType is a string type used to set the "search_type" of search and
+// multi search requests.
+//
+// Example usage:
+//
+//	var s SearchType = DfsQueryThenFetch
+//
+//	// Use s in an es.MultiSearchHeader().SearchType(...) call
+//
+// Constants:
+//   - QueryThenFetch: Scores documents with shard-local term frequencies.
+//   - DfsQueryThenFetch: Scores documents with term frequencies collected from all shards first.
+type SearchType string
+
+const (
+	// QueryThenFetch indicates scoring with shard-local term frequencies, the default.
+	QueryThenFetch SearchType = "query_then_fetch"
+
+	// DfsQueryThenFetch indicates scoring with global term frequencies, which is more accurate but slower.
+	DfsQueryThenFetch SearchType = "dfs_query_then_fetch"
+)
+
+func (searchType SearchType) String() string {
+	return string(searchType)
+}
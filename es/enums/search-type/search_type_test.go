@@ -0,0 +1,25 @@
+package searchtype_test
+
+import (
+	"testing"
+
+	SearchType "github.com/Trendyol/es-query-builder/es/enums/search-type"
+
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+func Test_SearchTypeString(t *testing.T) {
+	tests := []struct {
+		searchType SearchType.SearchType
+		result     string
+	}{
+		{SearchType.QueryThenFetch, "query_then_fetch"},
+		{SearchType.DfsQueryThenFetch, "dfs_query_then_fetch"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.result, func(t *testing.T) {
+			assert.Equal(t, test.result, test.searchType.String())
+		})
+	}
+}
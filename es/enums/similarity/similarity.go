@@ -0,0 +1,37 @@
+package similarity
+
+// Similarity represents the vector similarity functions of dense_vector fields.
+//
+// Similarity is a string type used to set the "similarity" parameter of
+// dense_vector fields and kNN searches.
+//
+// Example usage:
+//
+//	var s Similarity = Cosine
+//
+//	// Use s in a mapping.DenseVector(...).Similarity(...) call
+//
+// Constants:
+//   - Cosine: Cosine similarity.
+//   - DotProduct: Dot product of unit-length vectors.
+//   - L2Norm: Euclidean distance.
+//   - MaxInnerProduct: Maximum inner product, without unit-length normalization.
+type Similarity string
+
+const (
+	// Cosine indicates cosine similarity.
+	Cosine Similarity = "cosine"
+
+	// DotProduct indicates the dot product of unit-length vectors.
+	DotProduct Similarity = "dot_product"
+
+	// L2Norm indicates similarity based on the euclidean distance.
+	L2Norm Similarity = "l2_norm"
+
+	// MaxInnerProduct indicates the maximum inner product.
+	MaxInnerProduct Similarity = "max_inner_product"
+)
+
+func (similarity Similarity) String() string {
+	return string(similarity)
+}
@@ -0,0 +1,27 @@
+package similarity_test
+
+import (
+	"testing"
+
+	Similarity "github.com/Trendyol/es-query-builder/es/enums/similarity"
+
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+func Test_SimilarityString(t *testing.T) {
+	tests := []struct {
+		similarity Similarity.Similarity
+		result     string
+	}{
+		{Similarity.Cosine, "cosine"},
+		{Similarity.DotProduct, "dot_product"},
+		{Similarity.L2Norm, "l2_norm"},
+		{Similarity.MaxInnerProduct, "max_inner_product"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.result, func(t *testing.T) {
+			assert.Equal(t, test.result, test.similarity.String())
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package suggestmode
+
+// SuggestMode represents which suggestions the term and phrase suggesters return.
+//
+// SuggestMode is a string type used to set the "suggest_mode" of term
+// suggesters and phrase suggester direct generators.
+//
+// Example usage:
+//
+//	var m SuggestMode = Popular
+//
+//	// Use m in an es.TermSuggester(...).SuggestMode(...) call
+//
+// Constants:
+//   - Missing: Only suggests terms that are not in the index.
+//   - Popular: Only suggests terms that occur in more documents than the original term.
+//   - Always: Suggests any matching terms.
+type SuggestMode string
+
+const (
+	// Missing indicates that only terms missing from the index get suggestions, the default.
+	Missing SuggestMode = "missing"
+
+	// Popular indicates that only terms more frequent than the original term are suggested.
+	Popular SuggestMode = "popular"
+
+	// Always indicates that any matching term is suggested.
+	Always SuggestMode = "always"
+)
+
+func (suggestMode SuggestMode) String() string {
+	return string(suggestMode)
+}
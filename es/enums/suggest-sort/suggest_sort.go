@@ -0,0 +1,28 @@
+package suggestsort
+
+// SuggestSort represents how the term suggester orders the suggestions of each term.
+//
+// SuggestSort is a string type used to set the "sort" of term suggesters.
+//
+// Example usage:
+//
+//	var s SuggestSort = Frequency
+//
+//	// Use s in an es.TermSuggester(...).Sort(...) call
+//
+// Constants:
+//   - Score: Sorts by score, then document frequency, then the term itself.
+//   - Frequency: Sorts by document frequency, then score, then the term itself.
+type SuggestSort string
+
+const (
+	// Score indicates that suggestions are sorted by score first, the default.
+	Score SuggestSort = "score"
+
+	// Frequency indicates that suggestions are sorted by document frequency first.
+	Frequency SuggestSort = "frequency"
+)
+
+func (suggestSort SuggestSort) String() string {
+	return string(suggestSort)
+}
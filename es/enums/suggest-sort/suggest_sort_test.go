@@ -0,0 +1,25 @@
+package suggestsort_test
+
+import (
+	"testing"
+
+	SuggestSort "github.com/Trendyol/es-query-builder/es/enums/suggest-sort"
+
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+func Test_SuggestSortString(t *testing.T) {
+	tests := []struct {
+		suggestSort SuggestSort.SuggestSort
+		result      string
+	}{
+		{SuggestSort.Score, "score"},
+		{SuggestSort.Frequency, "frequency"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.result, func(t *testing.T) {
+			assert.Equal(t, test.result, test.suggestSort.String())
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package versiontype
+
+// VersionType represents how the version of a document write is checked.
+//
+// VersionType is a string type used to set the "version_type" of index,
+// create, update and delete operations.
+//
+// Example usage:
+//
+//	var v VersionType = External
+//
+//	// Use v in a bulk.Index(...).Version(...).VersionType(...) call
+//
+// Constants:
+//   - Internal: The version must match the current version of the document.
+//   - External: The version must be greater than the current version of the document.
+//   - ExternalGte: The version must be greater than or equal to the current version of the document.
+type VersionType string
+
+const (
+	// Internal indicates versions managed by Elasticsearch.
+	Internal VersionType = "internal"
+
+	// External indicates versions managed by an external system, which must increase.
+	External VersionType = "external"
+
+	// ExternalGte indicates external versions that may also repeat the current version.
+	ExternalGte VersionType = "external_gte"
+)
+
+func (versionType VersionType) String() string {
+	return string(versionType)
+}
@@ -0,0 +1,26 @@
+package versiontype_test
+
+import (
+	"testing"
+
+	VersionType "github.com/Trendyol/es-query-builder/es/enums/version-type"
+
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+func Test_VersionTypeString(t *testing.T) {
+	tests := []struct {
+		versionType VersionType.VersionType
+		result      string
+	}{
+		{VersionType.Internal, "internal"},
+		{VersionType.External, "external"},
+		{VersionType.ExternalGte, "external_gte"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.result, func(t *testing.T) {
+			assert.Equal(t, test.result, test.versionType.String())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package es
+
+// Field is a typed reference to a document field whose values are of type T.
+//
+// Field values are usually generated from document structs with the
+// es-field-gen command, so field names are checked by the compiler and the
+// typed helpers such as es.TermField only accept values of the field's type.
+//
+// Example usage:
+//
+//	var category es.Field[string] = "category.keyword"
+//	t := es.TermField(category, "books")
+//	// t is equal to es.Term("category.keyword", "books")
+type Field[T any] string
+
+// Name returns the full dotted path of the field.
+//
+// Example usage:
+//
+//	s := es.Sort(ProductFields.Date.Name())
+//
+// Returns:
+//
+//	The field path as a string.
+func (f Field[T]) Name() string {
+	return string(f)
+}
+
+// Path is a reference to an object field that groups other fields.
+type Path string
+
+// Name returns the full dotted path of the object field.
+//
+// Returns:
+//
+//	The object path as a string.
+func (p Path) Name() string {
+	return string(p)
+}
+
+// NestedPath is a reference to a field mapped with the nested type. Only
+// es.NestedPath values are accepted by es.NestedField.
+type NestedPath string
+
+// Name returns the full dotted path of the nested field.
+//
+// Returns:
+//
+//	The nested path as a string.
+func (p NestedPath) Name() string {
+	return string(p)
+}
+
+// TermField creates a term query on a typed field.
+//
+// Example usage:
+//
+//	t := es.TermField(ProductFields.Category.Keyword, "books")
+//	// es.TermField(ProductFields.Category.Keyword, 42) does not compile
+//
+// Parameters:
+//   - field: The es.Field to match.
+//   - value: The term to search for, of the field's value type.
+//
+// Returns:
+//
+//	An es.termType object, equal to es.Term(field.Name(), value).
+func TermField[T any](field Field[T], value T) termType {
+	return Term(field.Name(), value)
+}
+
+// TermsField creates a terms query on a typed field.
+//
+// Example usage:
+//
+//	t := es.TermsField(ProductFields.Brand, "apple", "samsung")
+//
+// Parameters:
+//   - field: The es.Field to match.
+//   - values: The terms to search for, of the field's value type.
+//
+// Returns:
+//
+//	An es.termsType object, equal to es.Terms(field.Name(), values...).
+func TermsField[T primitive](field Field[T], values ...T) termsType {
+	return Terms(field.Name(), values...)
+}
+
+// MatchField creates a match query on a typed field.
+//
+// Example usage:
+//
+//	m := es.MatchField(ProductFields.Category, "books")
+//
+// Parameters:
+//   - field: The es.Field to match.
+//   - query: The value to match, of the field's value type.
+//
+// Returns:
+//
+//	An es.matchType object, equal to es.Match(field.Name(), query).
+func MatchField[T any](field Field[T], query T) matchType {
+	return Match(field.Name(), query)
+}
+
+// NestedField creates a nested query on a typed nested path.
+//
+// Example usage:
+//
+//	n := es.NestedField(ProductFields.Variants.NestedPath,
+//		es.TermField(ProductFields.Variants.Color, "red"),
+//	)
+//
+// Parameters:
+//   - path: The es.NestedPath of the nested field.
+//   - nestedQuery: The query to run on the nested documents.
+//
+// Returns:
+//
+//	An es.nestedType object, equal to es.Nested(path.Name(), nestedQuery).
+func NestedField[T any](path NestedPath, nestedQuery T) nestedType {
+	return Nested(path.Name(), nestedQuery)
+}
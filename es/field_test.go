@@ -0,0 +1,96 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+type brand string
+
+var productFields = struct {
+	Variants struct {
+		es.NestedPath
+		Color es.Field[string]
+	}
+	Category struct {
+		es.Field[string]
+		Keyword es.Field[string]
+	}
+	Brand es.Field[brand]
+	Price es.Field[float64]
+}{
+	Variants: struct {
+		es.NestedPath
+		Color es.Field[string]
+	}{NestedPath: "variants", Color: "variants.color"},
+	Category: struct {
+		es.Field[string]
+		Keyword es.Field[string]
+	}{Field: "category", Keyword: "category.keyword"},
+	Brand: "brand",
+	Price: "price",
+}
+
+////   Field   ////
+
+func Test_Field_Name_should_return_field_path(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.Equal(t, "category", productFields.Category.Name())
+	assert.Equal(t, "category.keyword", productFields.Category.Keyword.Name())
+	assert.Equal(t, "variants", productFields.Variants.Name())
+	assert.Equal(t, "seller", es.Path("seller").Name())
+}
+
+func Test_TermField_should_create_json_with_term_field_inside_query(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Filter(
+		es.TermField(productFields.Category.Keyword, "books"),
+		es.TermField(productFields.Price, 10),
+	))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	assert.Equal(t,
+		"{\"query\":{\"bool\":{\"filter\":[{\"term\":{\"category.keyword\":{\"value\":\"books\"}}},{\"term\":{\"price\":{\"value\":10}}}]}}}",
+		bodyJSON,
+	)
+}
+
+func Test_TermsField_should_create_json_with_terms_field_inside_query(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.TermsField(productFields.Brand, "apple", "samsung"))
+
+	// When Then
+	assert.IsTypeString(t, "es.termsType", es.TermsField(productFields.Brand, "apple"))
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	assert.Equal(t, "{\"query\":{\"terms\":{\"brand\":[\"apple\",\"samsung\"]}}}", bodyJSON)
+}
+
+func Test_MatchField_should_create_json_with_match_field_inside_query(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.MatchField(productFields.Category.Field, "books"))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	assert.Equal(t, "{\"query\":{\"match\":{\"category\":{\"query\":\"books\"}}}}", bodyJSON)
+}
+
+func Test_NestedField_should_create_json_with_nested_field_inside_query(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.NestedField(productFields.Variants.NestedPath, es.TermField(productFields.Variants.Color, "red")))
+
+	// When Then
+	assert.IsTypeString(t, "es.nestedType", es.NestedField(productFields.Variants.NestedPath, es.MatchAll()))
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	assert.Equal(t,
+		"{\"query\":{\"nested\":{\"path\":\"variants\",\"query\":{\"term\":{\"variants.color\":{\"value\":\"red\"}}}}}}",
+		bodyJSON,
+	)
+}
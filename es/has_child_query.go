@@ -0,0 +1,112 @@
+package es
+
+import ScoreMode "github.com/Trendyol/es-query-builder/es/enums/score-mode"
+
+type hasChildType Object
+
+// HasChild creates a new es.hasChildType object for a has_child query.
+//
+// The has_child query returns parent documents whose child documents, joined
+// through a join field, match the given query.
+//
+// Example usage:
+//
+//	hasChild := es.HasChild("listing", es.Term("status", "active")).
+//		ScoreMode(ScoreMode.Max).
+//		MinChildren(2)
+//	// hasChild now contains {"has_child": {"min_children": 2, "query": {...}, "score_mode": "max", "type": "listing"}}
+//
+// Parameters:
+//   - childType: The name of the child relation in the join field.
+//   - childQuery: The query the child documents have to match.
+//
+// Returns:
+//
+//	An es.hasChildType object with the "has_child" query.
+func HasChild[T any](childType string, childQuery T) hasChildType {
+	o := NewQuery(childQuery)
+	o["type"] = childType
+	return hasChildType{
+		"has_child": o,
+	}
+}
+
+// ScoreMode sets how the scores of the matching child documents are combined
+// into the score of the parent document.
+//
+// Parameters:
+//   - scoreMode: A ScoreMode.ScoreMode value, one of None, Avg, Max, Min or Sum.
+//
+// Returns:
+//
+//	The updated es.hasChildType object with the "score_mode" field set.
+func (hc hasChildType) ScoreMode(scoreMode ScoreMode.ScoreMode) hasChildType {
+	return hc.putInTheField("score_mode", scoreMode)
+}
+
+// MinChildren sets the minimum number of matching child documents a parent
+// document needs to be returned.
+//
+// Parameters:
+//   - minChildren: The minimum number of matching children.
+//
+// Returns:
+//
+//	The updated es.hasChildType object with the "min_children" field set.
+func (hc hasChildType) MinChildren(minChildren int) hasChildType {
+	return hc.putInTheField("min_children", minChildren)
+}
+
+// MaxChildren sets the maximum number of matching child documents a parent
+// document can have to be returned.
+//
+// Parameters:
+//   - maxChildren: The maximum number of matching children.
+//
+// Returns:
+//
+//	The updated es.hasChildType object with the "max_children" field set.
+func (hc hasChildType) MaxChildren(maxChildren int) hasChildType {
+	return hc.putInTheField("max_children", maxChildren)
+}
+
+// IgnoreUnmapped sets whether the query matches no documents instead of failing
+// when the child type is not mapped.
+//
+// Parameters:
+//   - ignoreUnmapped: A boolean enabling or disabling ignore_unmapped.
+//
+// Returns:
+//
+//	The updated es.hasChildType object with the "ignore_unmapped" field set.
+func (hc hasChildType) IgnoreUnmapped(ignoreUnmapped bool) hasChildType {
+	return hc.putInTheField("ignore_unmapped", ignoreUnmapped)
+}
+
+// InnerHits returns the matching child documents along with each parent document.
+//
+// Parameters:
+//   - innerHits: An es.innerHitsType created with es.InnerHits.
+//
+// Returns:
+//
+//	The updated es.hasChildType object with the "inner_hits" field set.
+func (hc hasChildType) InnerHits(innerHits innerHitsType) hasChildType {
+	return hc.putInTheField("inner_hits", innerHits)
+}
+
+// Boost sets the "boost" field in the has_child query.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.hasChildType object with the "boost" field set.
+func (hc hasChildType) Boost(boost float64) hasChildType {
+	return hc.putInTheField("boost", boost)
+}
+
+func (hc hasChildType) putInTheField(key string, value any) hasChildType {
+	return genericPutInTheField(hc, "has_child", key, value)
+}
@@ -0,0 +1,46 @@
+package es_test
+
+import (
+	"testing"
+
+	ScoreMode "github.com/Trendyol/es-query-builder/es/enums/score-mode"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   HasChild   ////
+
+func Test_HasChild_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.HasChild[any])
+}
+
+func Test_HasChild_should_create_hasChildType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	hasChild := es.HasChild("listing", es.Term("status", "active"))
+
+	// Then
+	assert.IsTypeString(t, "es.hasChildType", hasChild)
+	bodyJSON := assert.MarshalWithoutError(t, hasChild)
+	assert.Equal(t, "{\"has_child\":{\"query\":{\"term\":{\"status\":{\"value\":\"active\"}}},\"type\":\"listing\"}}", bodyJSON)
+}
+
+func Test_HasChild_should_create_json_with_all_parameters(t *testing.T) {
+	t.Parallel()
+	// Given
+	hasChild := es.HasChild("listing", es.Bool().Filter(es.Term("status", "active"))).
+		ScoreMode(ScoreMode.Max).
+		MinChildren(2).
+		MaxChildren(10).
+		IgnoreUnmapped(true).
+		InnerHits(es.InnerHits().Size(3)).
+		Boost(1.5)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, hasChild)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"has_child\":{\"boost\":1.5,\"ignore_unmapped\":true,\"inner_hits\":{\"size\":3},\"max_children\":10,\"min_children\":2,\"query\":{\"bool\":{\"filter\":[{\"term\":{\"status\":{\"value\":\"active\"}}}]}},\"score_mode\":\"max\",\"type\":\"listing\"}}", bodyJSON)
+}
@@ -0,0 +1,82 @@
+package es
+
+type hasParentType Object
+
+// HasParent creates a new es.hasParentType object for a has_parent query.
+//
+// The has_parent query returns child documents whose parent document, joined
+// through a join field, matches the given query.
+//
+// Example usage:
+//
+//	hasParent := es.HasParent("seller", es.Range("rating").GreaterThanOrEqual(4.5)).Score(true)
+//	// hasParent now contains {"has_parent": {"parent_type": "seller", "query": {...}, "score": true}}
+//
+// Parameters:
+//   - parentType: The name of the parent relation in the join field.
+//   - parentQuery: The query the parent document has to match.
+//
+// Returns:
+//
+//	An es.hasParentType object with the "has_parent" query.
+func HasParent[T any](parentType string, parentQuery T) hasParentType {
+	o := NewQuery(parentQuery)
+	o["parent_type"] = parentType
+	return hasParentType{
+		"has_parent": o,
+	}
+}
+
+// Score sets whether the score of the matching parent document is used as the
+// score of the child documents. By default, every child gets the same score.
+//
+// Parameters:
+//   - score: A boolean enabling or disabling parent scores.
+//
+// Returns:
+//
+//	The updated es.hasParentType object with the "score" field set.
+func (hp hasParentType) Score(score bool) hasParentType {
+	return hp.putInTheField("score", score)
+}
+
+// IgnoreUnmapped sets whether the query matches no documents instead of failing
+// when the parent type is not mapped.
+//
+// Parameters:
+//   - ignoreUnmapped: A boolean enabling or disabling ignore_unmapped.
+//
+// Returns:
+//
+//	The updated es.hasParentType object with the "ignore_unmapped" field set.
+func (hp hasParentType) IgnoreUnmapped(ignoreUnmapped bool) hasParentType {
+	return hp.putInTheField("ignore_unmapped", ignoreUnmapped)
+}
+
+// InnerHits returns the matching parent document along with each child document.
+//
+// Parameters:
+//   - innerHits: An es.innerHitsType created with es.InnerHits.
+//
+// Returns:
+//
+//	The updated es.hasParentType object with the "inner_hits" field set.
+func (hp hasParentType) InnerHits(innerHits innerHitsType) hasParentType {
+	return hp.putInTheField("inner_hits", innerHits)
+}
+
+// Boost sets the "boost" field in the has_parent query.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.hasParentType object with the "boost" field set.
+func (hp hasParentType) Boost(boost float64) hasParentType {
+	return hp.putInTheField("boost", boost)
+}
+
+func (hp hasParentType) putInTheField(key string, value any) hasParentType {
+	return genericPutInTheField(hp, "has_parent", key, value)
+}
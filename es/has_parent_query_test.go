@@ -0,0 +1,44 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   HasParent   ////
+
+func Test_HasParent_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.HasParent[any])
+}
+
+func Test_HasParent_should_create_hasParentType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	hasParent := es.HasParent("seller", es.Term("verified", true))
+
+	// Then
+	assert.IsTypeString(t, "es.hasParentType", hasParent)
+	bodyJSON := assert.MarshalWithoutError(t, hasParent)
+	assert.Equal(t, "{\"has_parent\":{\"parent_type\":\"seller\",\"query\":{\"term\":{\"verified\":{\"value\":true}}}}}", bodyJSON)
+}
+
+func Test_HasParent_should_create_json_with_all_parameters(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Filter(
+		es.HasParent("seller", es.Range("rating").GreaterThanOrEqual(4.5)).
+			Score(true).
+			IgnoreUnmapped(false).
+			InnerHits(es.InnerHits().Name("seller")).
+			Boost(2),
+	))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"bool\":{\"filter\":[{\"has_parent\":{\"boost\":2,\"ignore_unmapped\":false,\"inner_hits\":{\"name\":\"seller\"},\"parent_type\":\"seller\",\"query\":{\"range\":{\"rating\":{\"gte\":4.5}}},\"score\":true}}]}}}", bodyJSON)
+}
@@ -0,0 +1,311 @@
+package es
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MappedField describes a single field of an es.IndexMapping.
+type MappedField struct {
+	// Type is the mapping type of the field, e.g. "keyword", "text", "nested" or "object".
+	Type string
+	// Fielddata reports whether a text field has fielddata enabled.
+	Fielddata bool
+}
+
+// IndexMapping is the flattened field mapping of one or more indices, keyed by
+// the full dotted path of each field, including multi-fields such as
+// "title.keyword" and runtime fields.
+type IndexMapping struct {
+	fields map[string]MappedField
+}
+
+// ParseIndexMapping loads an index mapping from the output of the GET _mapping API.
+//
+// The response of GET /<index>/_mapping, a single {"mappings": {...}} object and a
+// bare {"properties": {...}} object are all accepted. When the response covers
+// several indices, their fields are merged and the first definition of a field wins.
+//
+// Example usage:
+//
+//	res, err := client.Indices.GetMapping(client.Indices.GetMapping.WithIndex("products"))
+//	...
+//	body, err := io.ReadAll(res.Body)
+//	mapping, err := es.ParseIndexMapping(body)
+//
+// Parameters:
+//   - data: The raw JSON mapping.
+//
+// Returns:
+//
+//	The parsed es.IndexMapping, or an error if the JSON is invalid or holds no mapping.
+func ParseIndexMapping(data []byte) (*IndexMapping, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var root Object
+	if err := decoder.Decode(&root); err != nil {
+		return nil, fmt.Errorf("invalid index mapping: %w", err)
+	}
+	if len(root) == 0 {
+		return nil, errors.New("invalid index mapping: expected a JSON object with mappings")
+	}
+	mapping := &IndexMapping{fields: map[string]MappedField{}}
+	if !mapping.addMappings(root) {
+		for _, index := range sortedKeys(root) {
+			if body, ok := root[index].(map[string]any); !ok || !mapping.addMappings(body) {
+				return nil, fmt.Errorf("invalid index mapping: %q has no mappings", index)
+			}
+		}
+	}
+	return mapping, nil
+}
+
+// addMappings adds the fields of {"mappings": {...}} or {"properties": {...}}
+// objects, reporting false when body is neither.
+func (m *IndexMapping) addMappings(body Object) bool {
+	_, isMappings := body["mappings"]
+	if mappings, ok := body["mappings"].(map[string]any); ok {
+		body = mappings
+	}
+	properties, hasProperties := body["properties"].(map[string]any)
+	runtime, hasRuntime := body["runtime"].(map[string]any)
+	if !hasProperties && !hasRuntime {
+		return isMappings
+	}
+	m.addProperties(properties, "")
+	m.addProperties(runtime, "")
+	m.resolveAliases(properties, "")
+	return true
+}
+
+func (m *IndexMapping) addProperties(properties map[string]any, prefix string) {
+	for _, name := range sortedKeys(properties) {
+		definition, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		path := prefix + name
+		fieldType, _ := definition["type"].(string)
+		if fieldType == "" {
+			fieldType = "object"
+		}
+		fielddata, _ := definition["fielddata"].(bool)
+		if _, exists := m.fields[path]; !exists {
+			m.fields[path] = MappedField{Type: fieldType, Fielddata: fielddata}
+		}
+		if children, hasChildren := definition["properties"].(map[string]any); hasChildren {
+			m.addProperties(children, path+".")
+		}
+		if multiFields, hasMultiFields := definition["fields"].(map[string]any); hasMultiFields {
+			m.addProperties(multiFields, path+".")
+		}
+	}
+}
+
+// resolveAliases replaces "alias" fields with the mapping of the field they point to.
+func (m *IndexMapping) resolveAliases(properties map[string]any, prefix string) {
+	for name, value := range properties {
+		definition, _ := value.(map[string]any)
+		if children, hasChildren := definition["properties"].(map[string]any); hasChildren {
+			m.resolveAliases(children, prefix+name+".")
+		}
+		target, isAlias := definition["path"].(string)
+		if definition["type"] != "alias" || !isAlias {
+			continue
+		}
+		if field, exists := m.fields[target]; exists {
+			m.fields[prefix+name] = field
+		}
+	}
+}
+
+// Field returns the mapping of the field at the given dotted path.
+//
+// Example usage:
+//
+//	field, ok := mapping.Field("title.keyword")
+//	// field.Type == "keyword"
+//
+// Parameters:
+//   - path: The full dotted path of the field.
+//
+// Returns:
+//
+//	The es.MappedField and true when the field is mapped, false otherwise.
+func (m *IndexMapping) Field(path string) (MappedField, bool) {
+	field, ok := m.fields[path]
+	return field, ok
+}
+
+// Validate checks a search body against the mapping and reports everything
+// es.Validate reports plus clauses that do not fit the mapped field types:
+// term and terms queries on text fields, nested queries, aggregations and sorts on
+// paths that are not nested, geo queries on fields that are not geo_point,
+// aggregations and sorts on text fields without fielddata and sorts on
+// unmapped fields. Fields of the "runtime_mappings" of the body count as mapped
+// and shadow index fields with the same name.
+//
+// Example usage:
+//
+//	query := es.NewQuery(es.Term("title", "phone"))
+//	errs := mapping.Validate(query)
+//	// errs[0].Error() == "query.term.title: term query on text field, use \"title.keyword\""
+//
+// Parameters:
+//   - query: The es.Object search body to validate.
+//
+// Returns:
+//
+//	The es.ValidationErrors found in the body, or nil when it is valid.
+func (m *IndexMapping) Validate(query Object) ValidationErrors {
+	v := &validator{mapping: m.withRuntimeMappings(query["runtime_mappings"])}
+	v.validateRoot(query)
+	return v.errors
+}
+
+// withRuntimeMappings returns a copy of the mapping with the search time runtime
+// fields added, or the mapping itself when there are none.
+func (m *IndexMapping) withRuntimeMappings(runtimeMappings any) *IndexMapping {
+	fields, ok := asObject(runtimeMappings)
+	if !ok || len(fields) == 0 {
+		return m
+	}
+	extended := &IndexMapping{fields: make(map[string]MappedField, len(m.fields)+len(fields))}
+	for path, field := range m.fields {
+		extended.fields[path] = field
+	}
+	extended.addRuntimeFields(fields, "")
+	return extended
+}
+
+func (m *IndexMapping) addRuntimeFields(fields Object, prefix string) {
+	for name, value := range fields {
+		definition := asObjectOrEmpty(value)
+		fieldType := ""
+		if rawType, exists := definition["type"]; exists {
+			fieldType = fmt.Sprint(rawType)
+		}
+		m.fields[prefix+name] = MappedField{Type: fieldType}
+		if children, hasChildren := asObject(definition["fields"]); hasChildren {
+			m.addRuntimeFields(children, prefix+name+".")
+		}
+	}
+}
+
+// keywordSubfield returns the path of a keyword multi-field of field, if any.
+func (m *IndexMapping) keywordSubfield(field string) (string, bool) {
+	if mapped, ok := m.fields[field+".keyword"]; ok && mapped.Type == "keyword" {
+		return field + ".keyword", true
+	}
+	prefix := field + "."
+	var subfields []string
+	for path, mapped := range m.fields {
+		if strings.HasPrefix(path, prefix) && !strings.Contains(path[len(prefix):], ".") && mapped.Type == "keyword" {
+			subfields = append(subfields, path)
+		}
+	}
+	if len(subfields) == 0 {
+		return "", false
+	}
+	sort.Strings(subfields)
+	return subfields[0], true
+}
+
+// textHint suggests a keyword subfield of a text field in error messages.
+func (m *IndexMapping) textHint(field string) string {
+	if keyword, ok := m.keywordSubfield(field); ok {
+		return fmt.Sprintf(", use %q", keyword)
+	}
+	return ""
+}
+
+func (v *validator) checkQueryMapping(name string, body Object, path string) {
+	switch name {
+	case "term", "terms", "terms_set":
+		field, ok := fieldKey(body, "boost", "_name")
+		if mapped, exists := v.mapping.Field(field); ok && exists && mapped.Type == "text" {
+			v.report(joinPath(path, field), "%s query on text field%s", name, v.mapping.textHint(field))
+		}
+	case "nested":
+		if nestedPath, _ := body["path"].(string); nestedPath != "" {
+			v.checkNestedPath(nestedPath, path)
+		}
+	case "geo_distance", "geo_bounding_box":
+		field, ok := fieldKey(body, "distance", "distance_type", "validation_method", "ignore_unmapped", "boost", "_name")
+		mapped, exists := v.mapping.Field(field)
+		// geo_shape fields are accepted by geo queries as well.
+		if ok && exists && mapped.Type != "geo_point" && mapped.Type != "geo_shape" {
+			v.report(joinPath(path, field), "%s query on %s field, expected geo_point", name, mapped.Type)
+		}
+	}
+}
+
+func (v *validator) checkNestedPath(nestedPath, path string) {
+	mapped, exists := v.mapping.Field(nestedPath)
+	switch {
+	case !exists:
+		v.report(path, "path %q is not mapped", nestedPath)
+	case mapped.Type != "nested":
+		v.report(path, "path %q is not a nested field (%s)", nestedPath, mapped.Type)
+	}
+}
+
+func (v *validator) checkAggregationMapping(name string, body Object, path string) {
+	switch name {
+	case "nested", "reverse_nested":
+		if nestedPath, _ := body["path"].(string); nestedPath != "" {
+			v.checkNestedPath(nestedPath, path)
+		}
+	case "multi_terms":
+		terms, _ := asArray(body["terms"])
+		for i := 0; i < len(terms); i++ {
+			field, _ := asObjectOrEmpty(terms[i])["field"].(string)
+			v.checkAggregatable(field, indexPath(joinPath(path, "terms"), i))
+		}
+	case "filter", "filters", "top_hits":
+	default:
+		field, _ := body["field"].(string)
+		v.checkAggregatable(field, path)
+	}
+}
+
+func (v *validator) checkAggregatable(field, path string) {
+	if mapped, exists := v.mapping.Field(field); exists && mapped.Type == "text" && !mapped.Fielddata {
+		v.report(path, "aggregation on text field %q without fielddata%s", field, v.mapping.textHint(field))
+	}
+}
+
+func (v *validator) checkSortMapping(field string, params Object, path string) {
+	if strings.HasPrefix(field, "_") {
+		return
+	}
+	mapped, exists := v.mapping.Field(field)
+	switch {
+	case !exists:
+		if _, hasUnmappedType := params["unmapped_type"]; !hasUnmappedType {
+			v.report(path, "sort on unmapped field %q", field)
+		}
+	case mapped.Type == "text" && !mapped.Fielddata:
+		v.report(path, "sort on text field %q without fielddata%s", field, v.mapping.textHint(field))
+	}
+}
+
+// fieldKey returns the single field key of a field level clause without
+// reporting problems, which es.Validate already does.
+func fieldKey(body Object, options ...string) (string, bool) {
+	field := ""
+	for key := range body {
+		if contains(options, key) {
+			continue
+		}
+		if field != "" {
+			return "", false
+		}
+		field = key
+	}
+	return field, field != ""
+}
@@ -0,0 +1,274 @@
+package es_test
+
+import (
+	"testing"
+
+	RuntimeFieldType "github.com/Trendyol/es-query-builder/es/enums/runtime-field-type"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+// nolint:golint,lll
+const productsMapping = `{
+  "products-v1": {
+    "mappings": {
+      "runtime": {"discounted": {"type": "double"}},
+      "properties": {
+        "title": {"type": "text", "fields": {"keyword": {"type": "keyword"}}},
+        "description": {"type": "text"},
+        "tags": {"type": "text", "fielddata": true},
+        "brand": {"type": "keyword"},
+        "price": {"type": "scaled_float", "scaling_factor": 100},
+        "date": {"type": "date"},
+        "location": {"type": "geo_point"},
+        "address": {"type": "keyword"},
+        "seller": {"properties": {"name": {"type": "keyword"}}},
+        "variants": {"type": "nested", "properties": {"color": {"type": "keyword"}, "price": {"type": "double"}}},
+        "name": {"type": "alias", "path": "title"}
+      }
+    }
+  }
+}`
+
+func parseProductsMapping(t *testing.T) *es.IndexMapping {
+	t.Helper()
+	mapping, err := es.ParseIndexMapping([]byte(productsMapping))
+	assert.Nil(t, err)
+	return mapping
+}
+
+////   ParseIndexMapping   ////
+
+func Test_ParseIndexMapping_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.ParseIndexMapping)
+}
+
+func Test_ParseIndexMapping_should_flatten_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	mapping := parseProductsMapping(t)
+
+	// When
+	keyword, keywordOk := mapping.Field("title.keyword")
+	seller, sellerOk := mapping.Field("seller")
+	nested, nestedOk := mapping.Field("variants.color")
+	runtime, runtimeOk := mapping.Field("discounted")
+	alias, aliasOk := mapping.Field("name")
+	tags, _ := mapping.Field("tags")
+	_, unmappedOk := mapping.Field("unknown")
+
+	// Then
+	assert.True(t, keywordOk && sellerOk && nestedOk && runtimeOk && aliasOk)
+	assert.False(t, unmappedOk)
+	assert.Equal(t, "keyword", keyword.Type)
+	assert.Equal(t, "object", seller.Type)
+	assert.Equal(t, "keyword", nested.Type)
+	assert.Equal(t, "double", runtime.Type)
+	assert.Equal(t, "text", alias.Type)
+	assert.True(t, tags.Fielddata)
+}
+
+func Test_ParseIndexMapping_should_accept_mappings_and_properties_objects(t *testing.T) {
+	t.Parallel()
+	// Given When
+	fromMappings, mappingsErr := es.ParseIndexMapping([]byte(`{"mappings":{"properties":{"a":{"type":"keyword"}}}}`))
+	fromProperties, propertiesErr := es.ParseIndexMapping([]byte(`{"properties":{"a":{"type":"keyword"}}}`))
+
+	// Then
+	assert.Nil(t, mappingsErr)
+	assert.Nil(t, propertiesErr)
+	_, mappingsOk := fromMappings.Field("a")
+	_, propertiesOk := fromProperties.Field("a")
+	assert.True(t, mappingsOk && propertiesOk)
+}
+
+func Test_ParseIndexMapping_should_accept_empty_mappings(t *testing.T) {
+	t.Parallel()
+	// Given When
+	empty, emptyErr := es.ParseIndexMapping([]byte(`{"idx":{"mappings":{}}}`))
+	multi, multiErr := es.ParseIndexMapping([]byte(`{"empty":{"mappings":{}},"products":{"mappings":{"properties":{"a":{"type":"keyword"}}}}}`))
+
+	// Then
+	assert.Nil(t, emptyErr)
+	assert.Nil(t, multiErr)
+	_, emptyOk := empty.Field("a")
+	field, multiOk := multi.Field("a")
+	assert.False(t, emptyOk)
+	assert.True(t, multiOk)
+	assert.Equal(t, "keyword", field.Type)
+}
+
+func Test_ParseIndexMapping_should_return_error_for_invalid_mapping(t *testing.T) {
+	t.Parallel()
+	// Given When
+	_, invalidErr := es.ParseIndexMapping([]byte(`{"products":`))
+	_, emptyErr := es.ParseIndexMapping([]byte(`{}`))
+	_, noMappingsErr := es.ParseIndexMapping([]byte(`{"products":{"settings":{}}}`))
+
+	// Then
+	assert.NotNil(t, invalidErr)
+	assert.Equal(t, "invalid index mapping: expected a JSON object with mappings", emptyErr.Error())
+	assert.Equal(t, "invalid index mapping: \"products\" has no mappings", noMappingsErr.Error())
+}
+
+////   IndexMapping.Validate   ////
+
+func Test_IndexMapping_Validate_should_return_nil_for_query_matching_the_mapping(t *testing.T) {
+	t.Parallel()
+	// Given
+	mapping := parseProductsMapping(t)
+	query := es.NewQuery(es.Bool().Filter(
+		es.Term("title.keyword", "phone"),
+		es.Terms("brand", "apple"),
+		es.Match("title", "phone"),
+		es.Nested("variants", es.Term("variants.color", "red")),
+		es.GeoDistance("location", 41, 29, "10km"),
+	)).
+		Sort(es.Sort("date"), es.Sort("_score"), es.Sort("discounted")).
+		Aggs(
+			es.Agg("brands", es.TermsAgg("brand")),
+			es.Agg("tags", es.TermsAgg("tags")),
+			es.Agg("variants", es.NestedAgg("variants").Aggs(es.Agg("colors", es.TermsAgg("variants.color")))),
+		)
+
+	// When
+	errs := mapping.Validate(query)
+
+	// Then
+	assert.True(t, errs == nil)
+}
+
+func Test_IndexMapping_Validate_should_report_term_on_text_field(t *testing.T) {
+	t.Parallel()
+	// Given
+	mapping := parseProductsMapping(t)
+	query := es.NewQuery(es.Bool().Filter(es.Term("title", "phone"), es.Terms("description", "a")))
+
+	// When
+	errs := mapping.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"query.bool.filter[0].term.title: term query on text field, use \"title.keyword\"",
+		"query.bool.filter[1].terms.description: terms query on text field",
+	}, validationMessages(errs))
+}
+
+func Test_IndexMapping_Validate_should_report_nested_query_on_non_nested_path(t *testing.T) {
+	t.Parallel()
+	// Given
+	mapping := parseProductsMapping(t)
+	query := es.NewQuery(es.Bool().Filter(
+		es.Nested("seller", es.Term("seller.name", "a")),
+		es.Nested("sellers", es.Term("sellers.name", "a")),
+	))
+
+	// When
+	errs := mapping.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"query.bool.filter[0].nested: path \"seller\" is not a nested field (object)",
+		"query.bool.filter[1].nested: path \"sellers\" is not mapped",
+	}, validationMessages(errs))
+}
+
+func Test_IndexMapping_Validate_should_report_geo_distance_on_non_geo_point_field(t *testing.T) {
+	t.Parallel()
+	// Given
+	mapping := parseProductsMapping(t)
+	query := es.NewQuery(es.GeoDistance("address", 41, 29, "10km"))
+
+	// When
+	errs := mapping.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"query.geo_distance.address: geo_distance query on keyword field, expected geo_point",
+	}, validationMessages(errs))
+}
+
+func Test_IndexMapping_Validate_should_report_aggregation_on_text_field_without_fielddata(t *testing.T) {
+	t.Parallel()
+	// Given
+	mapping := parseProductsMapping(t)
+	query := es.NewAggs(
+		es.Agg("titles", es.TermsAgg("title")),
+		es.Agg("pairs", es.MultiTermsAgg(es.TermAgg("brand"), es.TermAgg("description"))),
+		es.Agg("sellers", es.NestedAgg("seller")),
+	)
+
+	// When
+	errs := mapping.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"aggs.pairs.multi_terms.terms[1]: aggregation on text field \"description\" without fielddata",
+		"aggs.sellers.nested: path \"seller\" is not a nested field (object)",
+		"aggs.titles.terms: aggregation on text field \"title\" without fielddata, use \"title.keyword\"",
+	}, validationMessages(errs))
+}
+
+func Test_IndexMapping_Validate_should_report_sort_on_unmapped_field(t *testing.T) {
+	t.Parallel()
+	// Given
+	mapping := parseProductsMapping(t)
+	query := es.NewQuery(es.MatchAll())
+	query["sort"] = es.Array{
+		es.Sort("created_at"),
+		es.Sort("title"),
+		es.Sort("variants.price").Nested(es.NestedSort("seller")),
+		"updated_at",
+		es.Object{"deleted_at": es.Object{"unmapped_type": "date"}},
+	}
+
+	// When
+	errs := mapping.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"sort[0].created_at: sort on unmapped field \"created_at\"",
+		"sort[1].title: sort on text field \"title\" without fielddata, use \"title.keyword\"",
+		"sort[2].variants.price.nested: path \"seller\" is not a nested field (object)",
+		"sort[3]: sort on unmapped field \"updated_at\"",
+	}, validationMessages(errs))
+}
+
+func Test_IndexMapping_Validate_should_accept_fields_of_runtime_mappings(t *testing.T) {
+	t.Parallel()
+	// Given
+	mapping := parseProductsMapping(t)
+	query := es.NewQuery(es.Term("title", "phone")).
+		RuntimeMappings(
+			es.RuntimeField("margin", RuntimeFieldType.Double),
+			es.RuntimeField("title", RuntimeFieldType.Keyword),
+			es.RuntimeField("prices", RuntimeFieldType.Composite).Fields(es.RuntimeField("discount", RuntimeFieldType.Double)),
+		).
+		Sort(es.Sort("margin"), es.Sort("prices.discount"), es.Sort("created_at"))
+
+	// When
+	errs := mapping.Validate(query)
+	_, mutated := mapping.Field("margin")
+
+	// Then
+	assert.Equal(t, []string{
+		"sort[2].created_at: sort on unmapped field \"created_at\"",
+	}, validationMessages(errs))
+	assert.False(t, mutated)
+}
+
+func Test_IndexMapping_Validate_should_include_structural_errors(t *testing.T) {
+	t.Parallel()
+	// Given
+	mapping := parseProductsMapping(t)
+	query := es.NewQuery(es.Range("title"))
+
+	// When
+	errs := mapping.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{"query.range.title: no bounds"}, validationMessages(errs))
+}
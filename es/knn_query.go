@@ -0,0 +1,190 @@
+package es
+
+type knnType Object
+
+// Knn creates a new es.knnType object for an approximate k-nearest neighbor
+// search on a dense_vector field.
+//
+// The same object can be used as a query clause, for example inside
+// Bool().Should(...) for hybrid lexical and vector retrieval, or added to the
+// top-level "knn" section of a search request with Object.Knn.
+//
+// Example usage:
+//
+//	k := es.Knn("title_embedding").
+//		QueryVector(0.12, -0.48, 0.91).
+//		K(10).
+//		NumCandidates(100)
+//	// k now contains {"knn": {"field": "title_embedding", "k": 10, "num_candidates": 100, "query_vector": [...]}}
+//
+// Parameters:
+//   - field: The name of the dense_vector field to search.
+//
+// Returns:
+//
+//	An es.knnType object with the "field" set.
+func Knn(field string) knnType {
+	return knnType{
+		"knn": Object{
+			"field": field,
+		},
+	}
+}
+
+// QueryVector sets the "query_vector" the nearest neighbors are searched for.
+// It replaces a query vector builder set before.
+//
+// Parameters:
+//   - vector: The query vector, with as many dimensions as the field.
+//
+// Returns:
+//
+//	The updated es.knnType object with the "query_vector" field set.
+func (k knnType) QueryVector(vector ...float32) knnType {
+	if knn, ok := k["knn"].(Object); ok {
+		delete(knn, "query_vector_builder")
+	}
+	return k.putInTheField("query_vector", vector)
+}
+
+// QueryVectorBuilder makes Elasticsearch build the query vector from text with
+// a deployed text embedding model. It replaces a query vector set before.
+//
+// Example usage:
+//
+//	k := es.Knn("title_embedding").QueryVectorBuilder("sentence-transformers__all-minilm-l6-v2", "running shoes")
+//	// k now contains {"knn": {..., "query_vector_builder": {"text_embedding": {"model_id": "...", "model_text": "running shoes"}}}}
+//
+// Parameters:
+//   - modelID: The id of the text embedding model.
+//   - modelText: The text the query vector is built from.
+//
+// Returns:
+//
+//	The updated es.knnType object with the "query_vector_builder" field set.
+func (k knnType) QueryVectorBuilder(modelID, modelText string) knnType {
+	if knn, ok := k["knn"].(Object); ok {
+		delete(knn, "query_vector")
+	}
+	return k.putInTheField("query_vector_builder", Object{
+		"text_embedding": Object{
+			"model_id":   modelID,
+			"model_text": modelText,
+		},
+	})
+}
+
+// K sets the number of nearest neighbors to return.
+//
+// Parameters:
+//   - numberOfNeighbors: The number of nearest neighbors.
+//
+// Returns:
+//
+//	The updated es.knnType object with the "k" field set.
+func (k knnType) K(numberOfNeighbors int) knnType {
+	return k.putInTheField("k", numberOfNeighbors)
+}
+
+// NumCandidates sets the number of candidates considered on each shard. Higher
+// values improve accuracy at the cost of speed.
+//
+// Parameters:
+//   - numCandidates: The number of candidates per shard, at least k.
+//
+// Returns:
+//
+//	The updated es.knnType object with the "num_candidates" field set.
+func (k knnType) NumCandidates(numCandidates int) knnType {
+	return k.putInTheField("num_candidates", numCandidates)
+}
+
+// Similarity sets the minimum similarity a vector needs to be a match. The value
+// is computed with the similarity function the field is mapped with.
+//
+// Parameters:
+//   - similarity: The minimum similarity.
+//
+// Returns:
+//
+//	The updated es.knnType object with the "similarity" field set.
+func (k knnType) Similarity(similarity float64) knnType {
+	return k.putInTheField("similarity", similarity)
+}
+
+// Boost sets the "boost" of the knn search, which weights its scores against
+// the scores of the query when both are used.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.knnType object with the "boost" field set.
+func (k knnType) Boost(boost float64) knnType {
+	return k.putInTheField("boost", boost)
+}
+
+// InnerHits sets the "inner_hits" of a knn search on a nested dense_vector field.
+//
+// Parameters:
+//   - innerHits: An es.innerHitsType created with es.InnerHits.
+//
+// Returns:
+//
+//	The updated es.knnType object with the "inner_hits" field set.
+func (k knnType) InnerHits(innerHits innerHitsType) knnType {
+	return k.putInTheField("inner_hits", innerHits)
+}
+
+// Filter adds queries that documents must match to be considered as nearest
+// neighbors. Unlike a post filter, the filter is applied during the search, so
+// k matches are returned when enough documents match it.
+//
+// Example usage:
+//
+//	k := es.Knn("title_embedding").QueryVector(vector...).K(10).
+//		Filter(es.Term("brand", "acme"), es.Bool().MustNot(es.Term("stock", 0)))
+//	// k now contains a "filter" field with both queries.
+//
+// Parameters:
+//   - filters: The query clauses, such as es.Term or es.Bool results. Nil values are skipped.
+//
+// Returns:
+//
+//	The updated es.knnType object with the queries added to "filter".
+func (k knnType) Filter(filters ...any) knnType {
+	knn, ok := k["knn"].(Object)
+	if !ok {
+		return k
+	}
+	filter, ok := knn["filter"].(Array)
+	if !ok {
+		filter = make(Array, 0, len(filters))
+	}
+	for i := 0; i < len(filters); i++ {
+		if field, fOk := correctType(filters[i]); fOk {
+			filter = append(filter, field)
+		}
+	}
+	knn["filter"] = filter
+	return k
+}
+
+// search returns a copy of the knn search, so the section or retriever it is
+// added to does not change when the builder is changed later.
+func (k knnType) search() Object {
+	inner, _ := k["knn"].(Object)
+	search := make(Object, len(inner))
+	for key, value := range inner {
+		search[key] = value
+	}
+	if filter, ok := search["filter"].(Array); ok {
+		search["filter"] = append(Array{}, filter...)
+	}
+	return search
+}
+
+func (k knnType) putInTheField(key string, value any) knnType {
+	return genericPutInTheField(k, "knn", key, value)
+}
@@ -0,0 +1,113 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Knn   ////
+
+func Test_Knn_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.Knn)
+}
+
+func Test_Knn_should_create_knnType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	knn := es.Knn("title_embedding")
+
+	// Then
+	assert.IsTypeString(t, "es.knnType", knn)
+	bodyJSON := assert.MarshalWithoutError(t, knn)
+	assert.Equal(t, "{\"knn\":{\"field\":\"title_embedding\"}}", bodyJSON)
+}
+
+func Test_Knn_should_create_json_with_all_parameters(t *testing.T) {
+	t.Parallel()
+	// Given
+	knn := es.Knn("title_embedding").
+		QueryVector(0.5, -0.25, 1).
+		K(10).
+		NumCandidates(100).
+		Similarity(0.7).
+		Boost(0.4).
+		InnerHits(es.InnerHits().Size(2)).
+		Filter(es.Term("brand", "acme"), nil).
+		Filter(es.Bool().MustNot(es.Term("stock", 0)))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, knn)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"knn\":{\"boost\":0.4,\"field\":\"title_embedding\",\"filter\":[{\"term\":{\"brand\":{\"value\":\"acme\"}}},{\"bool\":{\"must_not\":[{\"term\":{\"stock\":{\"value\":0}}}]}}],\"inner_hits\":{\"size\":2},\"k\":10,\"num_candidates\":100,\"query_vector\":[0.5,-0.25,1],\"similarity\":0.7}}", bodyJSON)
+}
+
+func Test_Knn_query_vector_and_builder_should_replace_each_other(t *testing.T) {
+	t.Parallel()
+	// Given
+	withBuilder := es.Knn("title_embedding").QueryVector(1, 2).QueryVectorBuilder("minilm", "running shoes")
+	withVector := es.Knn("title_embedding").QueryVectorBuilder("minilm", "running shoes").QueryVector(1, 2)
+
+	// When Then
+	// nolint:golint,lll
+	assert.Equal(t, "{\"knn\":{\"field\":\"title_embedding\",\"query_vector_builder\":{\"text_embedding\":{\"model_id\":\"minilm\",\"model_text\":\"running shoes\"}}}}", assert.MarshalWithoutError(t, withBuilder))
+	assert.Equal(t, "{\"knn\":{\"field\":\"title_embedding\",\"query_vector\":[1,2]}}", assert.MarshalWithoutError(t, withVector))
+}
+
+func Test_Knn_should_be_usable_inside_Bool_Should(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Should(
+		es.Match("title", "running shoes"),
+		es.Knn("title_embedding").QueryVector(1, 0).K(5).NumCandidates(50),
+	))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"bool\":{\"should\":[{\"match\":{\"title\":{\"query\":\"running shoes\"}}},{\"knn\":{\"field\":\"title_embedding\",\"k\":5,\"num_candidates\":50,\"query_vector\":[1,0]}}]}}}", bodyJSON)
+}
+
+func Test_Object_Knn_should_set_single_search_as_object(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Match("title", "shoes")).
+		Knn(es.Knn("title_embedding").QueryVector(1).K(10).NumCandidates(100).Boost(0.4))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"knn\":{\"boost\":0.4,\"field\":\"title_embedding\",\"k\":10,\"num_candidates\":100,\"query_vector\":[1]},\"query\":{\"match\":{\"title\":{\"query\":\"shoes\"}}}}", bodyJSON)
+}
+
+func Test_Object_Knn_should_set_several_searches_as_array(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(nil).Knn(
+		es.Knn("title_embedding").QueryVector(1).K(5),
+		es.Knn("image_embedding").QueryVector(0).K(5),
+	).Knn()
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"knn\":[{\"field\":\"title_embedding\",\"k\":5,\"query_vector\":[1]},{\"field\":\"image_embedding\",\"k\":5,\"query_vector\":[0]}],\"query\":{}}", bodyJSON)
+}
+
+func Test_Object_Knn_should_copy_knn_search(t *testing.T) {
+	t.Parallel()
+	// Given
+	knn := es.Knn("title_embedding").QueryVector(1).K(5).Filter(es.Term("brand", "acme"))
+	query := es.NewQuery(nil).Knn(knn)
+
+	// When
+	knn.K(50).Filter(es.Term("color", "red"))
+
+	// Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"knn\":{\"field\":\"title_embedding\",\"filter\":[{\"term\":{\"brand\":{\"value\":\"acme\"}}}],\"k\":5,\"query_vector\":[1]},\"query\":{}}", bodyJSON)
+}
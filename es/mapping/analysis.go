@@ -0,0 +1,405 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type analysisType es.Object
+
+type analyzerType es.Object
+
+type normalizerType es.Object
+
+type tokenizerType es.Object
+
+type tokenFilterType es.Object
+
+type charFilterType es.Object
+
+// Analysis creates an empty "analysis" object for index settings.
+//
+// Example usage:
+//
+//	a := mapping.Analysis().
+//		Analyzer("autocomplete", mapping.CustomAnalyzer("autocomplete").Filter("lowercase")).
+//		Tokenizer("autocomplete", mapping.EdgeNGramTokenizer(2, 20).TokenChars("letter", "digit"))
+//
+// Returns:
+//
+//	An empty mapping.analysisType object.
+func Analysis() analysisType {
+	return analysisType{}
+}
+
+// Analyzer adds a named analyzer.
+//
+// Parameters:
+//   - name: The name fields refer to the analyzer by.
+//   - analyzer: The analyzer, usually created with mapping.CustomAnalyzer.
+//
+// Returns:
+//
+//	The updated mapping.analysisType object with the analyzer added.
+func (a analysisType) Analyzer(name string, analyzer analyzerType) analysisType {
+	return putInTheField(a, "analyzer", name, analyzer)
+}
+
+// Normalizer adds a named normalizer for keyword fields.
+//
+// Parameters:
+//   - name: The name fields refer to the normalizer by.
+//   - normalizer: The normalizer created with mapping.CustomNormalizer.
+//
+// Returns:
+//
+//	The updated mapping.analysisType object with the normalizer added.
+func (a analysisType) Normalizer(name string, normalizer normalizerType) analysisType {
+	return putInTheField(a, "normalizer", name, normalizer)
+}
+
+// Tokenizer adds a named tokenizer.
+//
+// Parameters:
+//   - name: The name analyzers refer to the tokenizer by.
+//   - tokenizer: The tokenizer, created with mapping.Tokenizer or a typed constructor.
+//
+// Returns:
+//
+//	The updated mapping.analysisType object with the tokenizer added.
+func (a analysisType) Tokenizer(name string, tokenizer tokenizerType) analysisType {
+	return putInTheField(a, "tokenizer", name, tokenizer)
+}
+
+// Filter adds a named token filter.
+//
+// Parameters:
+//   - name: The name analyzers refer to the filter by.
+//   - filter: The token filter, created with mapping.TokenFilter or a typed constructor.
+//
+// Returns:
+//
+//	The updated mapping.analysisType object with the token filter added.
+func (a analysisType) Filter(name string, filter tokenFilterType) analysisType {
+	return putInTheField(a, "filter", name, filter)
+}
+
+// CharFilter adds a named character filter.
+//
+// Parameters:
+//   - name: The name analyzers refer to the filter by.
+//   - charFilter: The character filter, created with mapping.CharFilter or a typed constructor.
+//
+// Returns:
+//
+//	The updated mapping.analysisType object with the character filter added.
+func (a analysisType) CharFilter(name string, charFilter charFilterType) analysisType {
+	return putInTheField(a, "char_filter", name, charFilter)
+}
+
+// CustomAnalyzer creates a custom analyzer built from a tokenizer and optional filters.
+//
+// Example usage:
+//
+//	a := mapping.CustomAnalyzer("standard").Filter("lowercase", "asciifolding")
+//	// a now contains {"type": "custom", "tokenizer": "standard", "filter": ["lowercase", "asciifolding"]}
+//
+// Parameters:
+//   - tokenizer: The name of a built-in or custom tokenizer.
+//
+// Returns:
+//
+//	A mapping.analyzerType object.
+func CustomAnalyzer(tokenizer string) analyzerType {
+	return analyzerType{
+		"type":      "custom",
+		"tokenizer": tokenizer,
+	}
+}
+
+// Filter sets the token filters applied, in order, to the tokens.
+//
+// Parameters:
+//   - filters: The names of built-in or custom token filters.
+//
+// Returns:
+//
+//	The updated mapping.analyzerType object with the "filter" field set.
+func (a analyzerType) Filter(filters ...string) analyzerType {
+	a["filter"] = filters
+	return a
+}
+
+// CharFilter sets the character filters applied, in order, before tokenizing.
+//
+// Parameters:
+//   - charFilters: The names of built-in or custom character filters.
+//
+// Returns:
+//
+//	The updated mapping.analyzerType object with the "char_filter" field set.
+func (a analyzerType) CharFilter(charFilters ...string) analyzerType {
+	a["char_filter"] = charFilters
+	return a
+}
+
+// PositionIncrementGap sets the position gap inserted between the values of array fields.
+//
+// Parameters:
+//   - positionIncrementGap: The gap between values.
+//
+// Returns:
+//
+//	The updated mapping.analyzerType object with the "position_increment_gap" field set.
+func (a analyzerType) PositionIncrementGap(positionIncrementGap int) analyzerType {
+	a["position_increment_gap"] = positionIncrementGap
+	return a
+}
+
+// CustomNormalizer creates a custom normalizer for keyword fields.
+//
+// Example usage:
+//
+//	n := mapping.CustomNormalizer().Filter("lowercase")
+//	// n now contains {"type": "custom", "filter": ["lowercase"]}
+//
+// Returns:
+//
+//	A mapping.normalizerType object.
+func CustomNormalizer() normalizerType {
+	return normalizerType{
+		"type": "custom",
+	}
+}
+
+// Filter sets the token filters of the normalizer.
+//
+// Parameters:
+//   - filters: The names of token filters that work on single characters, such as "lowercase".
+//
+// Returns:
+//
+//	The updated mapping.normalizerType object with the "filter" field set.
+func (n normalizerType) Filter(filters ...string) normalizerType {
+	n["filter"] = filters
+	return n
+}
+
+// CharFilter sets the character filters of the normalizer.
+//
+// Parameters:
+//   - charFilters: The names of character filters.
+//
+// Returns:
+//
+//	The updated mapping.normalizerType object with the "char_filter" field set.
+func (n normalizerType) CharFilter(charFilters ...string) normalizerType {
+	n["char_filter"] = charFilters
+	return n
+}
+
+// Tokenizer creates a tokenizer of any type, configured with mapping.tokenizerType.Param.
+//
+// Example usage:
+//
+//	t := mapping.Tokenizer("pattern").Param("pattern", ",")
+//	// t now contains {"type": "pattern", "pattern": ","}
+//
+// Parameters:
+//   - kind: The tokenizer type.
+//
+// Returns:
+//
+//	A mapping.tokenizerType object.
+func Tokenizer(kind string) tokenizerType {
+	return tokenizerType{
+		"type": kind,
+	}
+}
+
+// EdgeNGramTokenizer creates an "edge_ngram" tokenizer, which emits the prefixes of words.
+//
+// Example usage:
+//
+//	t := mapping.EdgeNGramTokenizer(2, 20).TokenChars("letter", "digit")
+//
+// Parameters:
+//   - minGram: The minimum prefix length.
+//   - maxGram: The maximum prefix length.
+//
+// Returns:
+//
+//	A mapping.tokenizerType object.
+func EdgeNGramTokenizer(minGram, maxGram int) tokenizerType {
+	return Tokenizer("edge_ngram").Param("min_gram", minGram).Param("max_gram", maxGram)
+}
+
+// NGramTokenizer creates an "ngram" tokenizer, which emits all n-grams of words.
+//
+// Parameters:
+//   - minGram: The minimum n-gram length.
+//   - maxGram: The maximum n-gram length.
+//
+// Returns:
+//
+//	A mapping.tokenizerType object.
+func NGramTokenizer(minGram, maxGram int) tokenizerType {
+	return Tokenizer("ngram").Param("min_gram", minGram).Param("max_gram", maxGram)
+}
+
+// TokenChars sets the character classes kept in tokens by n-gram tokenizers.
+//
+// Parameters:
+//   - tokenChars: Character classes such as "letter", "digit" or "whitespace".
+//
+// Returns:
+//
+//	The updated mapping.tokenizerType object with the "token_chars" field set.
+func (t tokenizerType) TokenChars(tokenChars ...string) tokenizerType {
+	t["token_chars"] = tokenChars
+	return t
+}
+
+// Param sets a type-specific parameter of the tokenizer.
+//
+// Parameters:
+//   - key: The parameter name.
+//   - value: The parameter value.
+//
+// Returns:
+//
+//	The updated mapping.tokenizerType object with the parameter set.
+func (t tokenizerType) Param(key string, value any) tokenizerType {
+	t[key] = value
+	return t
+}
+
+// TokenFilter creates a token filter of any type, configured with mapping.tokenFilterType.Param.
+//
+// Example usage:
+//
+//	f := mapping.TokenFilter("length").Param("min", 2)
+//	// f now contains {"type": "length", "min": 2}
+//
+// Parameters:
+//   - kind: The token filter type.
+//
+// Returns:
+//
+//	A mapping.tokenFilterType object.
+func TokenFilter(kind string) tokenFilterType {
+	return tokenFilterType{
+		"type": kind,
+	}
+}
+
+// StopFilter creates a "stop" token filter that removes the given words.
+//
+// Parameters:
+//   - stopwords: The words to remove, or a predefined list such as "_english_".
+//
+// Returns:
+//
+//	A mapping.tokenFilterType object.
+func StopFilter(stopwords ...string) tokenFilterType {
+	return TokenFilter("stop").Param("stopwords", stopwords)
+}
+
+// SynonymFilter creates a "synonym" token filter.
+//
+// Example usage:
+//
+//	f := mapping.SynonymFilter("tv, television", "laptop => notebook")
+//
+// Parameters:
+//   - synonyms: Synonym rules in the Solr format.
+//
+// Returns:
+//
+//	A mapping.tokenFilterType object.
+func SynonymFilter(synonyms ...string) tokenFilterType {
+	return TokenFilter("synonym").Param("synonyms", synonyms)
+}
+
+// EdgeNGramFilter creates an "edge_ngram" token filter, which emits the prefixes of tokens.
+//
+// Parameters:
+//   - minGram: The minimum prefix length.
+//   - maxGram: The maximum prefix length.
+//
+// Returns:
+//
+//	A mapping.tokenFilterType object.
+func EdgeNGramFilter(minGram, maxGram int) tokenFilterType {
+	return TokenFilter("edge_ngram").Param("min_gram", minGram).Param("max_gram", maxGram)
+}
+
+// Param sets a type-specific parameter of the token filter.
+//
+// Parameters:
+//   - key: The parameter name.
+//   - value: The parameter value.
+//
+// Returns:
+//
+//	The updated mapping.tokenFilterType object with the parameter set.
+func (f tokenFilterType) Param(key string, value any) tokenFilterType {
+	f[key] = value
+	return f
+}
+
+// CharFilter creates a character filter of any type, configured with mapping.charFilterType.Param.
+//
+// Parameters:
+//   - kind: The character filter type.
+//
+// Returns:
+//
+//	A mapping.charFilterType object.
+func CharFilter(kind string) charFilterType {
+	return charFilterType{
+		"type": kind,
+	}
+}
+
+// MappingCharFilter creates a "mapping" character filter that replaces characters.
+//
+// Example usage:
+//
+//	f := mapping.MappingCharFilter("ı => i", "ş => s")
+//
+// Parameters:
+//   - mappings: Replacement rules in the "key => value" format.
+//
+// Returns:
+//
+//	A mapping.charFilterType object.
+func MappingCharFilter(mappings ...string) charFilterType {
+	return CharFilter("mapping").Param("mappings", mappings)
+}
+
+// PatternReplaceCharFilter creates a "pattern_replace" character filter.
+//
+// Parameters:
+//   - pattern: A Java regular expression.
+//   - replacement: The replacement string, which may refer to capture groups such as "$1".
+//
+// Returns:
+//
+//	A mapping.charFilterType object.
+func PatternReplaceCharFilter(pattern, replacement string) charFilterType {
+	return CharFilter("pattern_replace").Param("pattern", pattern).Param("replacement", replacement)
+}
+
+// Param sets a type-specific parameter of the character filter.
+//
+// Parameters:
+//   - key: The parameter name.
+//   - value: The parameter value.
+//
+// Returns:
+//
+//	The updated mapping.charFilterType object with the parameter set.
+func (c charFilterType) Param(key string, value any) charFilterType {
+	c[key] = value
+	return c
+}
@@ -0,0 +1,51 @@
+package mapping_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/mapping"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Analysis   ////
+
+func Test_Analysis_should_create_analysisType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	analysis := mapping.Analysis()
+
+	// Then
+	assert.IsTypeString(t, "mapping.analysisType", analysis)
+	assert.Equal(t, "{}", assert.MarshalWithoutError(t, analysis))
+}
+
+// nolint:golint,lll
+func Test_Analysis_should_create_json_with_all_components(t *testing.T) {
+	t.Parallel()
+	// Given
+	analysis := mapping.Analysis().
+		Analyzer("autocomplete", mapping.CustomAnalyzer("autocomplete").Filter("lowercase").CharFilter("turkish").PositionIncrementGap(100)).
+		Analyzer("search", mapping.CustomAnalyzer("standard").Filter("lowercase", "synonyms", "stops")).
+		Normalizer("lowercase", mapping.CustomNormalizer().Filter("lowercase").CharFilter("turkish")).
+		Tokenizer("autocomplete", mapping.EdgeNGramTokenizer(2, 10).TokenChars("letter")).
+		Tokenizer("grams", mapping.NGramTokenizer(3, 3)).
+		Tokenizer("comma", mapping.Tokenizer("pattern").Param("pattern", ",")).
+		Filter("synonyms", mapping.SynonymFilter("tv, television")).
+		Filter("stops", mapping.StopFilter("_english_")).
+		Filter("prefixes", mapping.EdgeNGramFilter(1, 5)).
+		Filter("length", mapping.TokenFilter("length").Param("min", 2)).
+		CharFilter("turkish", mapping.MappingCharFilter("ı => i")).
+		CharFilter("digits", mapping.PatternReplaceCharFilter("(\\d+)", "_$1")).
+		CharFilter("html", mapping.CharFilter("html_strip"))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, analysis)
+	assert.Equal(t,
+		"{\"analyzer\":{\"autocomplete\":{\"char_filter\":[\"turkish\"],\"filter\":[\"lowercase\"],\"position_increment_gap\":100,\"tokenizer\":\"autocomplete\",\"type\":\"custom\"},\"search\":{\"filter\":[\"lowercase\",\"synonyms\",\"stops\"],\"tokenizer\":\"standard\",\"type\":\"custom\"}},"+
+			"\"char_filter\":{\"digits\":{\"pattern\":\"(\\\\d+)\",\"replacement\":\"_$1\",\"type\":\"pattern_replace\"},\"html\":{\"type\":\"html_strip\"},\"turkish\":{\"mappings\":[\"ı =\\u003e i\"],\"type\":\"mapping\"}},"+
+			"\"filter\":{\"length\":{\"min\":2,\"type\":\"length\"},\"prefixes\":{\"max_gram\":5,\"min_gram\":1,\"type\":\"edge_ngram\"},\"stops\":{\"stopwords\":[\"_english_\"],\"type\":\"stop\"},\"synonyms\":{\"synonyms\":[\"tv, television\"],\"type\":\"synonym\"}},"+
+			"\"normalizer\":{\"lowercase\":{\"char_filter\":[\"turkish\"],\"filter\":[\"lowercase\"],\"type\":\"custom\"}},"+
+			"\"tokenizer\":{\"autocomplete\":{\"max_gram\":10,\"min_gram\":2,\"token_chars\":[\"letter\"],\"type\":\"edge_ngram\"},\"comma\":{\"pattern\":\",\",\"type\":\"pattern\"},\"grams\":{\"max_gram\":3,\"min_gram\":3,\"type\":\"ngram\"}}}",
+		bodyJSON,
+	)
+}
@@ -0,0 +1,152 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+	RuntimeFieldType "github.com/Trendyol/es-query-builder/es/enums/runtime-field-type"
+)
+
+type dynamicTemplateType es.Object
+
+// DynamicTemplate creates a named dynamic template, which maps new fields that
+// match its conditions.
+//
+// Example usage:
+//
+//	d := mapping.DynamicTemplate("ids_as_keywords").
+//		MatchMappingType("string").
+//		Match("*_id").
+//		Mapping(mapping.Keyword())
+//	// d now contains {"ids_as_keywords": {"match_mapping_type": "string", "match": "*_id", "mapping": {"type": "keyword"}}}
+//
+// Parameters:
+//   - name: The name of the template.
+//
+// Returns:
+//
+//	A mapping.dynamicTemplateType object.
+func DynamicTemplate(name string) dynamicTemplateType {
+	return dynamicTemplateType{
+		name: es.Object{},
+	}
+}
+
+// MatchMappingType matches new fields by the JSON data type detected for them,
+// such as "string", "long" or "object".
+//
+// Parameters:
+//   - types: One or more detected data types.
+//
+// Returns:
+//
+//	The updated mapping.dynamicTemplateType object with the "match_mapping_type" field set.
+func (d dynamicTemplateType) MatchMappingType(types ...string) dynamicTemplateType {
+	return d.putPatterns("match_mapping_type", types)
+}
+
+// UnmatchMappingType excludes new fields by their detected JSON data type.
+//
+// Parameters:
+//   - types: One or more detected data types.
+//
+// Returns:
+//
+//	The updated mapping.dynamicTemplateType object with the "unmatch_mapping_type" field set.
+func (d dynamicTemplateType) UnmatchMappingType(types ...string) dynamicTemplateType {
+	return d.putPatterns("unmatch_mapping_type", types)
+}
+
+// Match matches new fields by their name.
+//
+// Parameters:
+//   - patterns: One or more wildcard patterns.
+//
+// Returns:
+//
+//	The updated mapping.dynamicTemplateType object with the "match" field set.
+func (d dynamicTemplateType) Match(patterns ...string) dynamicTemplateType {
+	return d.putPatterns("match", patterns)
+}
+
+// Unmatch excludes new fields by their name.
+//
+// Parameters:
+//   - patterns: One or more wildcard patterns.
+//
+// Returns:
+//
+//	The updated mapping.dynamicTemplateType object with the "unmatch" field set.
+func (d dynamicTemplateType) Unmatch(patterns ...string) dynamicTemplateType {
+	return d.putPatterns("unmatch", patterns)
+}
+
+// PathMatch matches new fields by their full dotted path.
+//
+// Parameters:
+//   - patterns: One or more wildcard patterns.
+//
+// Returns:
+//
+//	The updated mapping.dynamicTemplateType object with the "path_match" field set.
+func (d dynamicTemplateType) PathMatch(patterns ...string) dynamicTemplateType {
+	return d.putPatterns("path_match", patterns)
+}
+
+// PathUnmatch excludes new fields by their full dotted path.
+//
+// Parameters:
+//   - patterns: One or more wildcard patterns.
+//
+// Returns:
+//
+//	The updated mapping.dynamicTemplateType object with the "path_unmatch" field set.
+func (d dynamicTemplateType) PathUnmatch(patterns ...string) dynamicTemplateType {
+	return d.putPatterns("path_unmatch", patterns)
+}
+
+// MatchPattern sets how the match and unmatch patterns are interpreted.
+//
+// Parameters:
+//   - matchPattern: "simple" for wildcard patterns or "regex" for regular expressions.
+//
+// Returns:
+//
+//	The updated mapping.dynamicTemplateType object with the "match_pattern" field set.
+func (d dynamicTemplateType) MatchPattern(matchPattern string) dynamicTemplateType {
+	return putInTheFirstField(d, "match_pattern", matchPattern)
+}
+
+// Mapping sets the mapping applied to matching fields.
+//
+// Example usage:
+//
+//	d := mapping.DynamicTemplate("texts").MatchMappingType("string").Mapping(mapping.Text().Analyzer("english"))
+//
+// Parameters:
+//   - property: A field mapping such as mapping.Keyword() or mapping.Text().
+//
+// Returns:
+//
+//	The updated mapping.dynamicTemplateType object with the "mapping" field set.
+func (d dynamicTemplateType) Mapping(property any) dynamicTemplateType {
+	return putInTheFirstField(d, "mapping", property)
+}
+
+// Runtime maps matching fields as runtime fields of the given type instead of indexing them.
+//
+// Parameters:
+//   - fieldType: A RuntimeFieldType.RuntimeFieldType value.
+//
+// Returns:
+//
+//	The updated mapping.dynamicTemplateType object with the "runtime" field set.
+func (d dynamicTemplateType) Runtime(fieldType RuntimeFieldType.RuntimeFieldType) dynamicTemplateType {
+	return putInTheFirstField(d, "runtime", es.Object{"type": fieldType})
+}
+
+// putPatterns writes a single pattern as a string and several as an array.
+func (d dynamicTemplateType) putPatterns(key string, patterns []string) dynamicTemplateType {
+	if len(patterns) == 1 {
+		return putInTheFirstField(d, key, patterns[0])
+	}
+	return putInTheFirstField(d, key, patterns)
+}
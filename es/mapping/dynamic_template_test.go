@@ -0,0 +1,41 @@
+package mapping_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/mapping"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   DynamicTemplate   ////
+
+func Test_DynamicTemplate_should_create_dynamicTemplateType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	template := mapping.DynamicTemplate("strings")
+
+	// Then
+	assert.IsTypeString(t, "mapping.dynamicTemplateType", template)
+	assert.Equal(t, "{\"strings\":{}}", assert.MarshalWithoutError(t, template))
+}
+
+func Test_DynamicTemplate_should_create_json_with_all_conditions(t *testing.T) {
+	t.Parallel()
+	// Given
+	template := mapping.DynamicTemplate("codes").
+		MatchMappingType("string", "long").
+		UnmatchMappingType("object").
+		Match("^code_\\d+$").
+		Unmatch("*_text").
+		PathMatch("meta.*", "attributes.*").
+		PathUnmatch("meta.raw").
+		MatchPattern("regex").
+		Mapping(mapping.Keyword().IgnoreAbove(64))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, template)
+	assert.Equal(t,
+		"{\"codes\":{\"mapping\":{\"ignore_above\":64,\"type\":\"keyword\"},\"match\":\"^code_\\\\d+$\",\"match_mapping_type\":[\"string\",\"long\"],\"match_pattern\":\"regex\",\"path_match\":[\"meta.*\",\"attributes.*\"],\"path_unmatch\":\"meta.raw\",\"unmatch\":\"*_text\",\"unmatch_mapping_type\":\"object\"}}",
+		bodyJSON,
+	)
+}
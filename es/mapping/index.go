@@ -0,0 +1,127 @@
+// Package mapping provides builders for index creation bodies: field mappings,
+// index settings with analysis components, dynamic templates and runtime fields.
+//
+// Every builder is a map underneath, like es.Object, so the results marshal with
+// encoding/json and can be converted to es.Object where a plain map is needed.
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type indexType es.Object
+
+// NewIndex creates an empty index creation body.
+//
+// Example usage:
+//
+//	body := mapping.NewIndex().
+//		Settings(mapping.Settings().NumberOfShards(1)).
+//		Mappings(mapping.Mappings().Properties(
+//			mapping.Property("title", mapping.Text()),
+//		))
+//	// body can be marshaled and sent to PUT /<index>.
+//
+// Returns:
+//
+//	An empty mapping.indexType object.
+func NewIndex() indexType {
+	return indexType{}
+}
+
+// Settings sets the "settings" of the index creation body.
+//
+// Example usage:
+//
+//	body := mapping.NewIndex().Settings(mapping.Settings().NumberOfReplicas(0))
+//	// body now contains {"settings": {"index": {"number_of_replicas": 0}}}
+//
+// Parameters:
+//   - settings: The mapping.settingsType object with the index settings.
+//
+// Returns:
+//
+//	The updated mapping.indexType object with the "settings" field set.
+func (i indexType) Settings(settings settingsType) indexType {
+	i["settings"] = settings
+	return i
+}
+
+// Mappings sets the "mappings" of the index creation body.
+//
+// Example usage:
+//
+//	body := mapping.NewIndex().Mappings(mapping.Mappings().Properties(
+//		mapping.Property("brand", mapping.Keyword()),
+//	))
+//	// body now contains {"mappings": {"properties": {"brand": {"type": "keyword"}}}}
+//
+// Parameters:
+//   - mappings: The mapping.mappingsType object with the field mappings.
+//
+// Returns:
+//
+//	The updated mapping.indexType object with the "mappings" field set.
+func (i indexType) Mappings(mappings mappingsType) indexType {
+	i["mappings"] = mappings
+	return i
+}
+
+// Aliases adds aliases that point to the created index.
+//
+// Example usage:
+//
+//	body := mapping.NewIndex().Aliases("products")
+//	// body now contains {"aliases": {"products": {}}}
+//
+// Parameters:
+//   - names: The alias names.
+//
+// Returns:
+//
+//	The updated mapping.indexType object with the "aliases" field set.
+func (i indexType) Aliases(names ...string) indexType {
+	aliases, ok := i["aliases"].(es.Object)
+	if !ok {
+		aliases = es.Object{}
+		i["aliases"] = aliases
+	}
+	for _, name := range names {
+		aliases[name] = es.Object{}
+	}
+	return i
+}
+
+// putInTheField sets key inside the object at parentKey, creating that object when it is missing.
+func putInTheField[T ~map[string]any](root T, parentKey, key string, value any) T {
+	container, ok := root[parentKey].(es.Object)
+	if !ok {
+		container = es.Object{}
+		root[parentKey] = container
+	}
+	container[key] = value
+	return root
+}
+
+// putInTheFirstField sets key inside the single named entry of a name keyed object.
+func putInTheFirstField[T ~map[string]any](root T, key string, value any) T {
+	for _, entry := range root {
+		if object, ok := entry.(es.Object); ok {
+			object[key] = value
+			break
+		}
+	}
+	return root
+}
+
+// reduceEntries merges single entry objects, such as mapping.Property results,
+// into one object keyed by their names.
+func reduceEntries[T ~map[string]any](entries []T) es.Object {
+	reduced := es.Object{}
+	for _, entry := range entries {
+		for key, value := range entry {
+			reduced[key] = value
+		}
+	}
+	return reduced
+}
@@ -0,0 +1,82 @@
+package mapping_test
+
+import (
+	"testing"
+
+	Dynamic "github.com/Trendyol/es-query-builder/es/enums/dynamic"
+
+	"github.com/Trendyol/es-query-builder/es/mapping"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   NewIndex   ////
+
+func Test_NewIndex_should_exist_on_mapping_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, mapping.NewIndex)
+}
+
+func Test_NewIndex_should_create_indexType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	index := mapping.NewIndex()
+
+	// Then
+	assert.IsTypeString(t, "mapping.indexType", index)
+	assert.Equal(t, "{}", assert.MarshalWithoutError(t, index))
+}
+
+func Test_NewIndex_should_create_json_with_settings_mappings_and_aliases(t *testing.T) {
+	t.Parallel()
+	// Given
+	index := mapping.NewIndex().
+		Settings(mapping.Settings().NumberOfShards(1)).
+		Mappings(mapping.Mappings().Dynamic(Dynamic.Strict)).
+		Aliases("products").
+		Aliases("catalog")
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, index)
+	assert.Equal(t,
+		"{\"aliases\":{\"catalog\":{},\"products\":{}},\"mappings\":{\"dynamic\":\"strict\"},\"settings\":{\"index\":{\"number_of_shards\":1}}}",
+		bodyJSON,
+	)
+}
+
+// nolint:golint,lll
+func Test_NewIndex_should_build_pokedex_index_body(t *testing.T) {
+	t.Parallel()
+	// Given
+	index := mapping.NewIndex().
+		Settings(mapping.Settings().
+			RefreshInterval("1s").
+			NumberOfShards(1).
+			NumberOfReplicas(1).
+			MaxResultWindow(10_000).
+			MaxTermsCount(1024).
+			Analysis(mapping.Analysis().
+				Analyzer("pokemon_name_analyzer", mapping.CustomAnalyzer("pokemon_name_tokenizer").Filter("lowercase", "asciifolding")).
+				Tokenizer("pokemon_name_tokenizer", mapping.EdgeNGramTokenizer(2, 20).TokenChars("letter", "digit")),
+			),
+		).
+		Mappings(mapping.Mappings().Properties(
+			mapping.Property("name", mapping.Text().
+				Analyzer("pokemon_name_analyzer").
+				SearchAnalyzer("standard").
+				Fields(mapping.Property("keyword", mapping.Keyword().IgnoreAbove(256))),
+			),
+			mapping.Property("types", mapping.Nested().Properties(
+				mapping.Property("name", mapping.Keyword()),
+				mapping.Property("slot", mapping.Short()),
+			)),
+			mapping.Property("isDefault", mapping.Boolean()),
+		))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, index)
+	assert.Equal(t,
+		"{\"mappings\":{\"properties\":{\"isDefault\":{\"type\":\"boolean\"},\"name\":{\"analyzer\":\"pokemon_name_analyzer\",\"fields\":{\"keyword\":{\"ignore_above\":256,\"type\":\"keyword\"}},\"search_analyzer\":\"standard\",\"type\":\"text\"},\"types\":{\"properties\":{\"name\":{\"type\":\"keyword\"},\"slot\":{\"type\":\"short\"}},\"type\":\"nested\"}}},\"settings\":{\"analysis\":{\"analyzer\":{\"pokemon_name_analyzer\":{\"filter\":[\"lowercase\",\"asciifolding\"],\"tokenizer\":\"pokemon_name_tokenizer\",\"type\":\"custom\"}},\"tokenizer\":{\"pokemon_name_tokenizer\":{\"max_gram\":20,\"min_gram\":2,\"token_chars\":[\"letter\",\"digit\"],\"type\":\"edge_ngram\"}}},\"index\":{\"max_result_window\":10000,\"max_terms_count\":1024,\"number_of_replicas\":1,\"number_of_shards\":1,\"refresh_interval\":\"1s\"}}}",
+		bodyJSON,
+	)
+}
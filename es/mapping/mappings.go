@@ -0,0 +1,161 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+	Dynamic "github.com/Trendyol/es-query-builder/es/enums/dynamic"
+)
+
+type mappingsType es.Object
+
+// Mappings creates an empty "mappings" object of an index.
+//
+// Example usage:
+//
+//	m := mapping.Mappings().
+//		Dynamic(Dynamic.Strict).
+//		Properties(
+//			mapping.Property("title", mapping.Text()),
+//			mapping.Property("date", mapping.Date()),
+//		)
+//
+// Returns:
+//
+//	An empty mapping.mappingsType object.
+func Mappings() mappingsType {
+	return mappingsType{}
+}
+
+// Properties adds field mappings to the "properties" of the mappings.
+//
+// Example usage:
+//
+//	m := mapping.Mappings().Properties(mapping.Property("brand", mapping.Keyword()))
+//	// m now contains {"properties": {"brand": {"type": "keyword"}}}
+//
+// Parameters:
+//   - properties: The fields created with mapping.Property.
+//
+// Returns:
+//
+//	The updated mapping.mappingsType object with the "properties" field set.
+func (m mappingsType) Properties(properties ...propertyEntry) mappingsType {
+	return putProperties(m, properties)
+}
+
+// Dynamic sets how the mappings handle fields that are not mapped.
+//
+// Example usage:
+//
+//	m := mapping.Mappings().Dynamic(Dynamic.Strict)
+//	// m now contains {"dynamic": "strict"}
+//
+// Parameters:
+//   - dynamic: A Dynamic.Dynamic value.
+//
+// Returns:
+//
+//	The updated mapping.mappingsType object with the "dynamic" field set.
+func (m mappingsType) Dynamic(dynamic Dynamic.Dynamic) mappingsType {
+	m["dynamic"] = dynamic
+	return m
+}
+
+// DateDetection sets whether new string fields that look like dates are mapped as dates.
+//
+// Parameters:
+//   - dateDetection: A boolean enabling or disabling date detection.
+//
+// Returns:
+//
+//	The updated mapping.mappingsType object with the "date_detection" field set.
+func (m mappingsType) DateDetection(dateDetection bool) mappingsType {
+	m["date_detection"] = dateDetection
+	return m
+}
+
+// NumericDetection sets whether new string fields that look like numbers are mapped as numbers.
+//
+// Parameters:
+//   - numericDetection: A boolean enabling or disabling numeric detection.
+//
+// Returns:
+//
+//	The updated mapping.mappingsType object with the "numeric_detection" field set.
+func (m mappingsType) NumericDetection(numericDetection bool) mappingsType {
+	m["numeric_detection"] = numericDetection
+	return m
+}
+
+// SourceEnabled sets whether the original document is stored in the "_source" field.
+//
+// Example usage:
+//
+//	m := mapping.Mappings().SourceEnabled(false)
+//	// m now contains {"_source": {"enabled": false}}
+//
+// Parameters:
+//   - enabled: A boolean enabling or disabling _source.
+//
+// Returns:
+//
+//	The updated mapping.mappingsType object with the "_source" field set.
+func (m mappingsType) SourceEnabled(enabled bool) mappingsType {
+	return putInTheField(m, "_source", "enabled", enabled)
+}
+
+// DynamicTemplates adds dynamic templates, which are applied in order to new fields.
+//
+// Example usage:
+//
+//	m := mapping.Mappings().DynamicTemplates(
+//		mapping.DynamicTemplate("strings_as_keywords").
+//			MatchMappingType("string").
+//			Mapping(mapping.Keyword()),
+//	)
+//
+// Parameters:
+//   - templates: The templates created with mapping.DynamicTemplate.
+//
+// Returns:
+//
+//	The updated mapping.mappingsType object with the "dynamic_templates" field set.
+func (m mappingsType) DynamicTemplates(templates ...dynamicTemplateType) mappingsType {
+	dynamicTemplates, ok := m["dynamic_templates"].(es.Array)
+	if !ok {
+		dynamicTemplates = make(es.Array, 0, len(templates))
+	}
+	for _, template := range templates {
+		dynamicTemplates = append(dynamicTemplates, template)
+	}
+	m["dynamic_templates"] = dynamicTemplates
+	return m
+}
+
+// Runtime adds runtime fields, which are computed by scripts at query time.
+//
+// Example usage:
+//
+//	m := mapping.Mappings().Runtime(
+//		mapping.RuntimeField("day_of_week", RuntimeFieldType.Keyword).
+//			Script(es.ScriptSource("emit(doc['date'].value.dayOfWeekEnum.toString())", ScriptLanguage.Painless)),
+//	)
+//
+// Parameters:
+//   - fields: The runtime fields created with mapping.RuntimeField or es.RuntimeField.
+//
+// Returns:
+//
+//	The updated mapping.mappingsType object with the "runtime" field set.
+func (m mappingsType) Runtime(fields ...es.RuntimeMappingType) mappingsType {
+	runtime, ok := m["runtime"].(es.Object)
+	if !ok {
+		runtime = es.Object{}
+		m["runtime"] = runtime
+	}
+	for _, field := range fields {
+		for name, definition := range field {
+			runtime[name] = definition
+		}
+	}
+	return m
+}
@@ -0,0 +1,82 @@
+package mapping_test
+
+import (
+	"testing"
+
+	Dynamic "github.com/Trendyol/es-query-builder/es/enums/dynamic"
+	RuntimeFieldType "github.com/Trendyol/es-query-builder/es/enums/runtime-field-type"
+
+	"github.com/Trendyol/es-query-builder/es/mapping"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Mappings   ////
+
+func Test_Mappings_should_create_mappingsType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	mappings := mapping.Mappings()
+
+	// Then
+	assert.IsTypeString(t, "mapping.mappingsType", mappings)
+	assert.Equal(t, "{}", assert.MarshalWithoutError(t, mappings))
+}
+
+func Test_Mappings_Properties_should_merge_properties(t *testing.T) {
+	t.Parallel()
+	// Given
+	mappings := mapping.Mappings().
+		Properties(mapping.Property("brand", mapping.Keyword())).
+		Properties(mapping.Property("date", mapping.Date()))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, mappings)
+	assert.Equal(t, "{\"properties\":{\"brand\":{\"type\":\"keyword\"},\"date\":{\"type\":\"date\"}}}", bodyJSON)
+}
+
+func Test_Mappings_should_create_json_with_root_options(t *testing.T) {
+	t.Parallel()
+	// Given
+	mappings := mapping.Mappings().
+		Dynamic(Dynamic.Runtime).
+		DateDetection(false).
+		NumericDetection(true).
+		SourceEnabled(false)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, mappings)
+	assert.Equal(t,
+		"{\"_source\":{\"enabled\":false},\"date_detection\":false,\"dynamic\":\"runtime\",\"numeric_detection\":true}",
+		bodyJSON,
+	)
+}
+
+func Test_Mappings_DynamicTemplates_should_append_templates_in_order(t *testing.T) {
+	t.Parallel()
+	// Given
+	mappings := mapping.Mappings().
+		DynamicTemplates(mapping.DynamicTemplate("ids").Match("*_id").Mapping(mapping.Keyword())).
+		DynamicTemplates(mapping.DynamicTemplate("strings").MatchMappingType("string").Runtime(RuntimeFieldType.Keyword))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, mappings)
+	assert.Equal(t,
+		"{\"dynamic_templates\":[{\"ids\":{\"mapping\":{\"type\":\"keyword\"},\"match\":\"*_id\"}},{\"strings\":{\"match_mapping_type\":\"string\",\"runtime\":{\"type\":\"keyword\"}}}]}",
+		bodyJSON,
+	)
+}
+
+func Test_Mappings_Runtime_should_merge_runtime_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	mappings := mapping.Mappings().
+		Runtime(mapping.RuntimeField("discounted", RuntimeFieldType.Double)).
+		Runtime(mapping.RuntimeField("day", RuntimeFieldType.Date).Format("yyyy-MM-dd"))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, mappings)
+	assert.Equal(t,
+		"{\"runtime\":{\"day\":{\"format\":\"yyyy-MM-dd\",\"type\":\"date\"},\"discounted\":{\"type\":\"double\"}}}",
+		bodyJSON,
+	)
+}
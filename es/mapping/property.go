@@ -0,0 +1,48 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type propertyEntry es.Object
+
+// Property creates a named field mapping.
+//
+// The field mapping can be any of the field builders of this package, such as
+// mapping.Keyword or mapping.Nested, or a plain es.Object for field types
+// without a builder.
+//
+// Example usage:
+//
+//	p := mapping.Property("brand", mapping.Keyword().IgnoreAbove(256))
+//	// p now contains {"brand": {"type": "keyword", "ignore_above": 256}}
+//
+// Parameters:
+//   - name: The field name.
+//   - property: The field mapping.
+//
+// Returns:
+//
+//	A mapping.propertyEntry object representing the named field.
+func Property[T ~map[string]any](name string, property T) propertyEntry {
+	return propertyEntry{
+		name: property,
+	}
+}
+
+func putProperties[T ~map[string]any](root T, properties []propertyEntry) T {
+	return putEntries(root, "properties", properties)
+}
+
+// putEntries merges named entries into the object at key, creating it when it is missing.
+func putEntries[T ~map[string]any](root T, key string, entries []propertyEntry) T {
+	container, ok := root[key].(es.Object)
+	if !ok {
+		container = es.Object{}
+		root[key] = container
+	}
+	for name, value := range reduceEntries(entries) {
+		container[name] = value
+	}
+	return root
+}
@@ -0,0 +1,57 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type booleanType es.Object
+
+// Boolean creates a "boolean" field mapping.
+//
+// Returns:
+//
+//	A mapping.booleanType object.
+func Boolean() booleanType {
+	return booleanType{
+		"type": "boolean",
+	}
+}
+
+// NullValue sets the value indexed in place of explicit null values.
+//
+// Parameters:
+//   - nullValue: The replacement value.
+//
+// Returns:
+//
+//	The updated mapping.booleanType object with the "null_value" field set.
+func (b booleanType) NullValue(nullValue bool) booleanType {
+	b["null_value"] = nullValue
+	return b
+}
+
+// DocValues sets whether the field is stored on disk for sorting and aggregations.
+//
+// Parameters:
+//   - docValues: A boolean enabling or disabling doc values.
+//
+// Returns:
+//
+//	The updated mapping.booleanType object with the "doc_values" field set.
+func (b booleanType) DocValues(docValues bool) booleanType {
+	b["doc_values"] = docValues
+	return b
+}
+
+// Index sets whether the field is searchable.
+//
+// Parameters:
+//   - index: A boolean enabling or disabling indexing.
+//
+// Returns:
+//
+//	The updated mapping.booleanType object with the "index" field set.
+func (b booleanType) Index(index bool) booleanType {
+	b["index"] = index
+	return b
+}
@@ -0,0 +1,99 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type dateType es.Object
+
+// Date creates a "date" field mapping with millisecond resolution.
+//
+// Example usage:
+//
+//	d := mapping.Date().Format("yyyy-MM-dd||epoch_millis")
+//	// d now contains {"type": "date", "format": "yyyy-MM-dd||epoch_millis"}
+//
+// Returns:
+//
+//	A mapping.dateType object.
+func Date() dateType {
+	return dateType{
+		"type": "date",
+	}
+}
+
+// DateNanos creates a "date_nanos" field mapping with nanosecond resolution.
+//
+// Returns:
+//
+//	A mapping.dateType object.
+func DateNanos() dateType {
+	return dateType{
+		"type": "date_nanos",
+	}
+}
+
+// Format sets the date formats accepted by the field, separated by "||".
+//
+// Parameters:
+//   - format: A built-in format name such as "strict_date_optional_time" or a custom pattern.
+//
+// Returns:
+//
+//	The updated mapping.dateType object with the "format" field set.
+func (d dateType) Format(format string) dateType {
+	d["format"] = format
+	return d
+}
+
+// NullValue sets the date indexed in place of explicit null values.
+//
+// Parameters:
+//   - nullValue: The replacement date, in one of the formats of the field.
+//
+// Returns:
+//
+//	The updated mapping.dateType object with the "null_value" field set.
+func (d dateType) NullValue(nullValue string) dateType {
+	d["null_value"] = nullValue
+	return d
+}
+
+// IgnoreMalformed sets whether malformed dates are ignored instead of rejecting the document.
+//
+// Parameters:
+//   - ignoreMalformed: A boolean enabling or disabling ignore_malformed.
+//
+// Returns:
+//
+//	The updated mapping.dateType object with the "ignore_malformed" field set.
+func (d dateType) IgnoreMalformed(ignoreMalformed bool) dateType {
+	d["ignore_malformed"] = ignoreMalformed
+	return d
+}
+
+// DocValues sets whether the field is stored on disk for sorting and aggregations.
+//
+// Parameters:
+//   - docValues: A boolean enabling or disabling doc values.
+//
+// Returns:
+//
+//	The updated mapping.dateType object with the "doc_values" field set.
+func (d dateType) DocValues(docValues bool) dateType {
+	d["doc_values"] = docValues
+	return d
+}
+
+// Index sets whether the field is searchable.
+//
+// Parameters:
+//   - index: A boolean enabling or disabling indexing.
+//
+// Returns:
+//
+//	The updated mapping.dateType object with the "index" field set.
+func (d dateType) Index(index bool) dateType {
+	d["index"] = index
+	return d
+}
@@ -0,0 +1,91 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+	Similarity "github.com/Trendyol/es-query-builder/es/enums/similarity"
+)
+
+type denseVectorType es.Object
+
+// DenseVector creates a "dense_vector" field mapping for kNN search.
+//
+// Example usage:
+//
+//	v := mapping.DenseVector(384).Similarity(Similarity.Cosine).IndexOptions("hnsw", 16, 100)
+//	// v now contains {"type": "dense_vector", "dims": 384, "similarity": "cosine", "index_options": {...}}
+//
+// Parameters:
+//   - dims: The number of dimensions of the vectors.
+//
+// Returns:
+//
+//	A mapping.denseVectorType object.
+func DenseVector(dims int) denseVectorType {
+	return denseVectorType{
+		"type": "dense_vector",
+		"dims": dims,
+	}
+}
+
+// Similarity sets the similarity function used by kNN search on the field.
+//
+// Parameters:
+//   - similarity: A Similarity.Similarity value.
+//
+// Returns:
+//
+//	The updated mapping.denseVectorType object with the "similarity" field set.
+func (d denseVectorType) Similarity(similarity Similarity.Similarity) denseVectorType {
+	d["similarity"] = similarity
+	return d
+}
+
+// ElementType sets the type of the vector elements, such as "float", "byte" or "bit".
+//
+// Parameters:
+//   - elementType: The element type.
+//
+// Returns:
+//
+//	The updated mapping.denseVectorType object with the "element_type" field set.
+func (d denseVectorType) ElementType(elementType string) denseVectorType {
+	d["element_type"] = elementType
+	return d
+}
+
+// Index sets whether the vectors are indexed for kNN search.
+//
+// Parameters:
+//   - index: A boolean enabling or disabling indexing.
+//
+// Returns:
+//
+//	The updated mapping.denseVectorType object with the "index" field set.
+func (d denseVectorType) Index(index bool) denseVectorType {
+	d["index"] = index
+	return d
+}
+
+// IndexOptions sets the kNN index structure of the field.
+//
+// Example usage:
+//
+//	v := mapping.DenseVector(384).IndexOptions("int8_hnsw", 16, 100)
+//	// v now contains "index_options": {"type": "int8_hnsw", "m": 16, "ef_construction": 100}
+//
+// Parameters:
+//   - indexType: The index type, such as "hnsw", "int8_hnsw" or "flat".
+//   - m: The number of neighbors each node is connected to in the HNSW graph.
+//   - efConstruction: The number of candidates tracked while building the graph.
+//
+// Returns:
+//
+//	The updated mapping.denseVectorType object with the "index_options" field set.
+func (d denseVectorType) IndexOptions(indexType string, m, efConstruction int) denseVectorType {
+	d["index_options"] = es.Object{
+		"type":            indexType,
+		"m":               m,
+		"ef_construction": efConstruction,
+	}
+	return d
+}
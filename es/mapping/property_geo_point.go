@@ -0,0 +1,44 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type geoPointType es.Object
+
+// GeoPoint creates a "geo_point" field mapping for latitude/longitude pairs.
+//
+// Returns:
+//
+//	A mapping.geoPointType object.
+func GeoPoint() geoPointType {
+	return geoPointType{
+		"type": "geo_point",
+	}
+}
+
+// IgnoreMalformed sets whether malformed points are ignored instead of rejecting the document.
+//
+// Parameters:
+//   - ignoreMalformed: A boolean enabling or disabling ignore_malformed.
+//
+// Returns:
+//
+//	The updated mapping.geoPointType object with the "ignore_malformed" field set.
+func (g geoPointType) IgnoreMalformed(ignoreMalformed bool) geoPointType {
+	g["ignore_malformed"] = ignoreMalformed
+	return g
+}
+
+// IgnoreZValue sets whether the third dimension of points is ignored instead of rejected.
+//
+// Parameters:
+//   - ignoreZValue: A boolean enabling or disabling ignore_z_value.
+//
+// Returns:
+//
+//	The updated mapping.geoPointType object with the "ignore_z_value" field set.
+func (g geoPointType) IgnoreZValue(ignoreZValue bool) geoPointType {
+	g["ignore_z_value"] = ignoreZValue
+	return g
+}
@@ -0,0 +1,113 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type keywordType es.Object
+
+// Keyword creates a "keyword" field mapping for exact values.
+//
+// Example usage:
+//
+//	k := mapping.Keyword().IgnoreAbove(256)
+//	// k now contains {"type": "keyword", "ignore_above": 256}
+//
+// Returns:
+//
+//	A mapping.keywordType object.
+func Keyword() keywordType {
+	return keywordType{
+		"type": "keyword",
+	}
+}
+
+// IgnoreAbove sets the length above which strings are not indexed.
+//
+// Parameters:
+//   - ignoreAbove: The maximum string length.
+//
+// Returns:
+//
+//	The updated mapping.keywordType object with the "ignore_above" field set.
+func (k keywordType) IgnoreAbove(ignoreAbove int) keywordType {
+	k["ignore_above"] = ignoreAbove
+	return k
+}
+
+// Normalizer sets the normalizer applied to values before indexing and to query terms.
+//
+// Parameters:
+//   - normalizer: The name of a built-in or custom normalizer.
+//
+// Returns:
+//
+//	The updated mapping.keywordType object with the "normalizer" field set.
+func (k keywordType) Normalizer(normalizer string) keywordType {
+	k["normalizer"] = normalizer
+	return k
+}
+
+// NullValue sets the value indexed in place of explicit null values.
+//
+// Parameters:
+//   - nullValue: The replacement value.
+//
+// Returns:
+//
+//	The updated mapping.keywordType object with the "null_value" field set.
+func (k keywordType) NullValue(nullValue string) keywordType {
+	k["null_value"] = nullValue
+	return k
+}
+
+// DocValues sets whether the field is stored on disk for sorting and aggregations.
+//
+// Parameters:
+//   - docValues: A boolean enabling or disabling doc values.
+//
+// Returns:
+//
+//	The updated mapping.keywordType object with the "doc_values" field set.
+func (k keywordType) DocValues(docValues bool) keywordType {
+	k["doc_values"] = docValues
+	return k
+}
+
+// Index sets whether the field is searchable.
+//
+// Parameters:
+//   - index: A boolean enabling or disabling indexing.
+//
+// Returns:
+//
+//	The updated mapping.keywordType object with the "index" field set.
+func (k keywordType) Index(index bool) keywordType {
+	k["index"] = index
+	return k
+}
+
+// CopyTo copies the value of the field into other fields.
+//
+// Parameters:
+//   - fields: The names of the target fields.
+//
+// Returns:
+//
+//	The updated mapping.keywordType object with the "copy_to" field set.
+func (k keywordType) CopyTo(fields ...string) keywordType {
+	k["copy_to"] = fields
+	return k
+}
+
+// Fields adds multi-fields that index the same value in other ways.
+//
+// Parameters:
+//   - fields: The subfields created with mapping.Property.
+//
+// Returns:
+//
+//	The updated mapping.keywordType object with the "fields" field set.
+func (k keywordType) Fields(fields ...propertyEntry) keywordType {
+	return putEntries(k, "fields", fields)
+}
@@ -0,0 +1,230 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type numericType es.Object
+
+type scaledFloatType es.Object
+
+func numeric(fieldType string) numericType {
+	return numericType{
+		"type": fieldType,
+	}
+}
+
+// Long creates a "long" field mapping for signed 64-bit integers.
+//
+// Returns:
+//
+//	A mapping.numericType object.
+func Long() numericType {
+	return numeric("long")
+}
+
+// Integer creates an "integer" field mapping for signed 32-bit integers.
+//
+// Returns:
+//
+//	A mapping.numericType object.
+func Integer() numericType {
+	return numeric("integer")
+}
+
+// Short creates a "short" field mapping for signed 16-bit integers.
+//
+// Returns:
+//
+//	A mapping.numericType object.
+func Short() numericType {
+	return numeric("short")
+}
+
+// Byte creates a "byte" field mapping for signed 8-bit integers.
+//
+// Returns:
+//
+//	A mapping.numericType object.
+func Byte() numericType {
+	return numeric("byte")
+}
+
+// UnsignedLong creates an "unsigned_long" field mapping for unsigned 64-bit integers.
+//
+// Returns:
+//
+//	A mapping.numericType object.
+func UnsignedLong() numericType {
+	return numeric("unsigned_long")
+}
+
+// Double creates a "double" field mapping for double precision floating point numbers.
+//
+// Returns:
+//
+//	A mapping.numericType object.
+func Double() numericType {
+	return numeric("double")
+}
+
+// Float creates a "float" field mapping for single precision floating point numbers.
+//
+// Returns:
+//
+//	A mapping.numericType object.
+func Float() numericType {
+	return numeric("float")
+}
+
+// HalfFloat creates a "half_float" field mapping for half precision floating point numbers.
+//
+// Returns:
+//
+//	A mapping.numericType object.
+func HalfFloat() numericType {
+	return numeric("half_float")
+}
+
+// NullValue sets the value indexed in place of explicit null values.
+//
+// Example usage:
+//
+//	n := mapping.Integer().NullValue(0)
+//	// n now contains {"type": "integer", "null_value": 0}
+//
+// Parameters:
+//   - nullValue: The replacement value.
+//
+// Returns:
+//
+//	The updated mapping.numericType object with the "null_value" field set.
+func (n numericType) NullValue(nullValue any) numericType {
+	n["null_value"] = nullValue
+	return n
+}
+
+// Coerce sets whether strings and fractions are converted to the field type.
+//
+// Parameters:
+//   - coerce: A boolean enabling or disabling coercion.
+//
+// Returns:
+//
+//	The updated mapping.numericType object with the "coerce" field set.
+func (n numericType) Coerce(coerce bool) numericType {
+	n["coerce"] = coerce
+	return n
+}
+
+// IgnoreMalformed sets whether values of the wrong type are ignored instead of rejecting the document.
+//
+// Parameters:
+//   - ignoreMalformed: A boolean enabling or disabling ignore_malformed.
+//
+// Returns:
+//
+//	The updated mapping.numericType object with the "ignore_malformed" field set.
+func (n numericType) IgnoreMalformed(ignoreMalformed bool) numericType {
+	n["ignore_malformed"] = ignoreMalformed
+	return n
+}
+
+// DocValues sets whether the field is stored on disk for sorting and aggregations.
+//
+// Parameters:
+//   - docValues: A boolean enabling or disabling doc values.
+//
+// Returns:
+//
+//	The updated mapping.numericType object with the "doc_values" field set.
+func (n numericType) DocValues(docValues bool) numericType {
+	n["doc_values"] = docValues
+	return n
+}
+
+// Index sets whether the field is searchable.
+//
+// Parameters:
+//   - index: A boolean enabling or disabling indexing.
+//
+// Returns:
+//
+//	The updated mapping.numericType object with the "index" field set.
+func (n numericType) Index(index bool) numericType {
+	n["index"] = index
+	return n
+}
+
+// ScaledFloat creates a "scaled_float" field mapping, which stores floating point
+// numbers as longs multiplied by a fixed scaling factor.
+//
+// Example usage:
+//
+//	s := mapping.ScaledFloat(100)
+//	// s now contains {"type": "scaled_float", "scaling_factor": 100}
+//
+// Parameters:
+//   - scalingFactor: The factor values are multiplied by before indexing.
+//
+// Returns:
+//
+//	A mapping.scaledFloatType object.
+func ScaledFloat(scalingFactor float64) scaledFloatType {
+	return scaledFloatType{
+		"type":           "scaled_float",
+		"scaling_factor": scalingFactor,
+	}
+}
+
+// NullValue sets the value indexed in place of explicit null values.
+//
+// Parameters:
+//   - nullValue: The replacement value.
+//
+// Returns:
+//
+//	The updated mapping.scaledFloatType object with the "null_value" field set.
+func (s scaledFloatType) NullValue(nullValue float64) scaledFloatType {
+	s["null_value"] = nullValue
+	return s
+}
+
+// Coerce sets whether strings are converted to numbers.
+//
+// Parameters:
+//   - coerce: A boolean enabling or disabling coercion.
+//
+// Returns:
+//
+//	The updated mapping.scaledFloatType object with the "coerce" field set.
+func (s scaledFloatType) Coerce(coerce bool) scaledFloatType {
+	s["coerce"] = coerce
+	return s
+}
+
+// DocValues sets whether the field is stored on disk for sorting and aggregations.
+//
+// Parameters:
+//   - docValues: A boolean enabling or disabling doc values.
+//
+// Returns:
+//
+//	The updated mapping.scaledFloatType object with the "doc_values" field set.
+func (s scaledFloatType) DocValues(docValues bool) scaledFloatType {
+	s["doc_values"] = docValues
+	return s
+}
+
+// Index sets whether the field is searchable.
+//
+// Parameters:
+//   - index: A boolean enabling or disabling indexing.
+//
+// Returns:
+//
+//	The updated mapping.scaledFloatType object with the "index" field set.
+func (s scaledFloatType) Index(index bool) scaledFloatType {
+	s["index"] = index
+	return s
+}
@@ -0,0 +1,138 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+	Dynamic "github.com/Trendyol/es-query-builder/es/enums/dynamic"
+)
+
+type objectType es.Object
+
+type nestedType es.Object
+
+// Object creates an "object" field mapping for JSON objects, whose fields are
+// flattened into the parent document.
+//
+// Example usage:
+//
+//	o := mapping.Object().Properties(mapping.Property("name", mapping.Keyword()))
+//	// o now contains {"type": "object", "properties": {"name": {"type": "keyword"}}}
+//
+// Returns:
+//
+//	A mapping.objectType object.
+func Object() objectType {
+	return objectType{
+		"type": "object",
+	}
+}
+
+// Properties adds field mappings to the object.
+//
+// Parameters:
+//   - properties: The fields created with mapping.Property.
+//
+// Returns:
+//
+//	The updated mapping.objectType object with the "properties" field set.
+func (o objectType) Properties(properties ...propertyEntry) objectType {
+	return putProperties(o, properties)
+}
+
+// Enabled sets whether the object is parsed and indexed. Disabled objects are
+// only kept in _source.
+//
+// Parameters:
+//   - enabled: A boolean enabling or disabling the object.
+//
+// Returns:
+//
+//	The updated mapping.objectType object with the "enabled" field set.
+func (o objectType) Enabled(enabled bool) objectType {
+	o["enabled"] = enabled
+	return o
+}
+
+// Dynamic sets how the object handles fields that are not mapped.
+//
+// Parameters:
+//   - dynamic: A Dynamic.Dynamic value.
+//
+// Returns:
+//
+//	The updated mapping.objectType object with the "dynamic" field set.
+func (o objectType) Dynamic(dynamic Dynamic.Dynamic) objectType {
+	o["dynamic"] = dynamic
+	return o
+}
+
+// Nested creates a "nested" field mapping for arrays of objects that are
+// indexed as separate documents and queried with nested queries.
+//
+// Example usage:
+//
+//	n := mapping.Nested().Properties(
+//		mapping.Property("color", mapping.Keyword()),
+//		mapping.Property("price", mapping.Double()),
+//	)
+//
+// Returns:
+//
+//	A mapping.nestedType object.
+func Nested() nestedType {
+	return nestedType{
+		"type": "nested",
+	}
+}
+
+// Properties adds field mappings to the nested objects.
+//
+// Parameters:
+//   - properties: The fields created with mapping.Property.
+//
+// Returns:
+//
+//	The updated mapping.nestedType object with the "properties" field set.
+func (n nestedType) Properties(properties ...propertyEntry) nestedType {
+	return putProperties(n, properties)
+}
+
+// Dynamic sets how the nested objects handle fields that are not mapped.
+//
+// Parameters:
+//   - dynamic: A Dynamic.Dynamic value.
+//
+// Returns:
+//
+//	The updated mapping.nestedType object with the "dynamic" field set.
+func (n nestedType) Dynamic(dynamic Dynamic.Dynamic) nestedType {
+	n["dynamic"] = dynamic
+	return n
+}
+
+// IncludeInParent sets whether the fields of the nested objects are also added
+// to the parent document as a flattened object.
+//
+// Parameters:
+//   - includeInParent: A boolean enabling or disabling include_in_parent.
+//
+// Returns:
+//
+//	The updated mapping.nestedType object with the "include_in_parent" field set.
+func (n nestedType) IncludeInParent(includeInParent bool) nestedType {
+	n["include_in_parent"] = includeInParent
+	return n
+}
+
+// IncludeInRoot sets whether the fields of the nested objects are also added
+// to the root document as a flattened object.
+//
+// Parameters:
+//   - includeInRoot: A boolean enabling or disabling include_in_root.
+//
+// Returns:
+//
+//	The updated mapping.nestedType object with the "include_in_root" field set.
+func (n nestedType) IncludeInRoot(includeInRoot bool) nestedType {
+	n["include_in_root"] = includeInRoot
+	return n
+}
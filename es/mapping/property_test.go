@@ -0,0 +1,166 @@
+package mapping_test
+
+import (
+	"testing"
+
+	Dynamic "github.com/Trendyol/es-query-builder/es/enums/dynamic"
+	Similarity "github.com/Trendyol/es-query-builder/es/enums/similarity"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/es/mapping"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Property   ////
+
+func Test_Property_should_create_named_entry(t *testing.T) {
+	t.Parallel()
+	// Given When
+	property := mapping.Property("tags", es.Object{"type": "flattened"})
+
+	// Then
+	assert.IsTypeString(t, "mapping.propertyEntry", property)
+	assert.Equal(t, "{\"tags\":{\"type\":\"flattened\"}}", assert.MarshalWithoutError(t, property))
+}
+
+func Test_Text_should_create_json_with_all_options(t *testing.T) {
+	t.Parallel()
+	// Given
+	text := mapping.Text().
+		Analyzer("english").
+		SearchAnalyzer("standard").
+		Fielddata(true).
+		Index(true).
+		CopyTo("all").
+		Fields(mapping.Property("keyword", mapping.Keyword()))
+
+	// When Then
+	assert.IsTypeString(t, "mapping.textType", text)
+	bodyJSON := assert.MarshalWithoutError(t, text)
+	assert.Equal(t,
+		"{\"analyzer\":\"english\",\"copy_to\":[\"all\"],\"fielddata\":true,\"fields\":{\"keyword\":{\"type\":\"keyword\"}},\"index\":true,\"search_analyzer\":\"standard\",\"type\":\"text\"}",
+		bodyJSON,
+	)
+}
+
+func Test_Keyword_should_create_json_with_all_options(t *testing.T) {
+	t.Parallel()
+	// Given
+	keyword := mapping.Keyword().
+		IgnoreAbove(256).
+		Normalizer("lowercase").
+		NullValue("NULL").
+		DocValues(false).
+		Index(true).
+		CopyTo("all").
+		Fields(mapping.Property("text", mapping.Text()))
+
+	// When Then
+	assert.IsTypeString(t, "mapping.keywordType", keyword)
+	bodyJSON := assert.MarshalWithoutError(t, keyword)
+	assert.Equal(t,
+		"{\"copy_to\":[\"all\"],\"doc_values\":false,\"fields\":{\"text\":{\"type\":\"text\"}},\"ignore_above\":256,\"index\":true,\"normalizer\":\"lowercase\",\"null_value\":\"NULL\",\"type\":\"keyword\"}",
+		bodyJSON,
+	)
+}
+
+func Test_numeric_properties_should_create_json_with_their_types(t *testing.T) {
+	t.Parallel()
+	// Given
+	properties := mapping.Mappings().Properties(
+		mapping.Property("long", mapping.Long()),
+		mapping.Property("integer", mapping.Integer().NullValue(0).Coerce(false).IgnoreMalformed(true).DocValues(true).Index(false)),
+		mapping.Property("short", mapping.Short()),
+		mapping.Property("byte", mapping.Byte()),
+		mapping.Property("unsigned_long", mapping.UnsignedLong()),
+		mapping.Property("double", mapping.Double()),
+		mapping.Property("float", mapping.Float()),
+		mapping.Property("half_float", mapping.HalfFloat()),
+		mapping.Property("price", mapping.ScaledFloat(100).NullValue(0).Coerce(true).DocValues(true).Index(true)),
+	)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, properties)
+	assert.Equal(t,
+		"{\"properties\":{\"byte\":{\"type\":\"byte\"},\"double\":{\"type\":\"double\"},\"float\":{\"type\":\"float\"},\"half_float\":{\"type\":\"half_float\"},\"integer\":{\"coerce\":false,\"doc_values\":true,\"ignore_malformed\":true,\"index\":false,\"null_value\":0,\"type\":\"integer\"},\"long\":{\"type\":\"long\"},\"price\":{\"coerce\":true,\"doc_values\":true,\"index\":true,\"null_value\":0,\"scaling_factor\":100,\"type\":\"scaled_float\"},\"short\":{\"type\":\"short\"},\"unsigned_long\":{\"type\":\"unsigned_long\"}}}",
+		bodyJSON,
+	)
+}
+
+func Test_Date_should_create_json_with_format(t *testing.T) {
+	t.Parallel()
+	// Given
+	date := mapping.Date().Format("yyyy-MM-dd||epoch_millis").NullValue("1970-01-01").IgnoreMalformed(true).DocValues(true).Index(true)
+
+	// When Then
+	assert.IsTypeString(t, "mapping.dateType", date)
+	bodyJSON := assert.MarshalWithoutError(t, date)
+	assert.Equal(t,
+		"{\"doc_values\":true,\"format\":\"yyyy-MM-dd||epoch_millis\",\"ignore_malformed\":true,\"index\":true,\"null_value\":\"1970-01-01\",\"type\":\"date\"}",
+		bodyJSON,
+	)
+	assert.Equal(t, "{\"type\":\"date_nanos\"}", assert.MarshalWithoutError(t, mapping.DateNanos()))
+}
+
+func Test_Boolean_should_create_json_with_options(t *testing.T) {
+	t.Parallel()
+	// Given
+	boolean := mapping.Boolean().NullValue(false).DocValues(true).Index(false)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, boolean)
+	assert.Equal(t, "{\"doc_values\":true,\"index\":false,\"null_value\":false,\"type\":\"boolean\"}", bodyJSON)
+}
+
+func Test_Object_and_Nested_should_create_json_with_properties(t *testing.T) {
+	t.Parallel()
+	// Given
+	properties := mapping.Mappings().Properties(
+		mapping.Property("seller", mapping.Object().
+			Enabled(true).
+			Dynamic(Dynamic.False).
+			Properties(mapping.Property("name", mapping.Keyword())),
+		),
+		mapping.Property("variants", mapping.Nested().
+			Dynamic(Dynamic.Strict).
+			IncludeInParent(true).
+			IncludeInRoot(false).
+			Properties(mapping.Property("color", mapping.Keyword())),
+		),
+	)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, properties)
+	assert.Equal(t,
+		"{\"properties\":{\"seller\":{\"dynamic\":\"false\",\"enabled\":true,\"properties\":{\"name\":{\"type\":\"keyword\"}},\"type\":\"object\"},\"variants\":{\"dynamic\":\"strict\",\"include_in_parent\":true,\"include_in_root\":false,\"properties\":{\"color\":{\"type\":\"keyword\"}},\"type\":\"nested\"}}}",
+		bodyJSON,
+	)
+}
+
+func Test_GeoPoint_should_create_json_with_options(t *testing.T) {
+	t.Parallel()
+	// Given
+	geoPoint := mapping.GeoPoint().IgnoreMalformed(true).IgnoreZValue(false)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, geoPoint)
+	assert.Equal(t, "{\"ignore_malformed\":true,\"ignore_z_value\":false,\"type\":\"geo_point\"}", bodyJSON)
+}
+
+func Test_DenseVector_should_create_json_with_options(t *testing.T) {
+	t.Parallel()
+	// Given
+	vector := mapping.DenseVector(384).
+		Similarity(Similarity.Cosine).
+		ElementType("float").
+		Index(true).
+		IndexOptions("int8_hnsw", 16, 100)
+
+	// When Then
+	assert.IsTypeString(t, "mapping.denseVectorType", vector)
+	bodyJSON := assert.MarshalWithoutError(t, vector)
+	assert.Equal(t,
+		"{\"dims\":384,\"element_type\":\"float\",\"index\":true,\"index_options\":{\"ef_construction\":100,\"m\":16,\"type\":\"int8_hnsw\"},\"similarity\":\"cosine\",\"type\":\"dense_vector\"}",
+		bodyJSON,
+	)
+}
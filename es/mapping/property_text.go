@@ -0,0 +1,109 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type textType es.Object
+
+// Text creates a "text" field mapping for full-text search.
+//
+// Example usage:
+//
+//	t := mapping.Text().
+//		Analyzer("autocomplete").
+//		Fields(mapping.Property("keyword", mapping.Keyword().IgnoreAbove(256)))
+//	// t now contains {"type": "text", "analyzer": "autocomplete", "fields": {"keyword": {...}}}
+//
+// Returns:
+//
+//	A mapping.textType object.
+func Text() textType {
+	return textType{
+		"type": "text",
+	}
+}
+
+// Analyzer sets the analyzer used at index time, and at search time unless
+// a search analyzer is set.
+//
+// Parameters:
+//   - analyzer: The name of a built-in or custom analyzer.
+//
+// Returns:
+//
+//	The updated mapping.textType object with the "analyzer" field set.
+func (t textType) Analyzer(analyzer string) textType {
+	t["analyzer"] = analyzer
+	return t
+}
+
+// SearchAnalyzer sets the analyzer used for queries on the field.
+//
+// Parameters:
+//   - searchAnalyzer: The name of a built-in or custom analyzer.
+//
+// Returns:
+//
+//	The updated mapping.textType object with the "search_analyzer" field set.
+func (t textType) SearchAnalyzer(searchAnalyzer string) textType {
+	t["search_analyzer"] = searchAnalyzer
+	return t
+}
+
+// Fields adds multi-fields that index the same value in other ways, such as a
+// keyword subfield for sorting and aggregations.
+//
+// Example usage:
+//
+//	t := mapping.Text().Fields(mapping.Property("keyword", mapping.Keyword()))
+//	// "title.keyword" can now be used in term queries.
+//
+// Parameters:
+//   - fields: The subfields created with mapping.Property.
+//
+// Returns:
+//
+//	The updated mapping.textType object with the "fields" field set.
+func (t textType) Fields(fields ...propertyEntry) textType {
+	return putEntries(t, "fields", fields)
+}
+
+// Fielddata sets whether the field can be used in aggregations and sorts.
+//
+// Parameters:
+//   - fielddata: A boolean enabling or disabling fielddata.
+//
+// Returns:
+//
+//	The updated mapping.textType object with the "fielddata" field set.
+func (t textType) Fielddata(fielddata bool) textType {
+	t["fielddata"] = fielddata
+	return t
+}
+
+// Index sets whether the field is searchable.
+//
+// Parameters:
+//   - index: A boolean enabling or disabling indexing.
+//
+// Returns:
+//
+//	The updated mapping.textType object with the "index" field set.
+func (t textType) Index(index bool) textType {
+	t["index"] = index
+	return t
+}
+
+// CopyTo copies the value of the field into other fields.
+//
+// Parameters:
+//   - fields: The names of the target fields.
+//
+// Returns:
+//
+//	The updated mapping.textType object with the "copy_to" field set.
+func (t textType) CopyTo(fields ...string) textType {
+	t["copy_to"] = fields
+	return t
+}
@@ -0,0 +1,17 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+// RuntimeField creates a named runtime field, whose values are computed at
+// query time instead of being indexed.
+//
+// It is es.RuntimeField, so a runtime field written for the "runtime_mappings"
+// of a search request can be added to the mappings of an index unchanged.
+//
+// Example usage:
+//
+//	r := mapping.RuntimeField("day_of_week", RuntimeFieldType.Keyword).
+//		Script(es.ScriptSource("emit(doc['date'].value.dayOfWeekEnum.toString())", ScriptLanguage.Painless))
+var RuntimeField = es.RuntimeField
@@ -0,0 +1,60 @@
+package mapping_test
+
+import (
+	"testing"
+
+	RuntimeFieldType "github.com/Trendyol/es-query-builder/es/enums/runtime-field-type"
+	ScriptLanguage "github.com/Trendyol/es-query-builder/es/enums/script-language"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/es/mapping"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   RuntimeField   ////
+
+func Test_RuntimeField_should_create_es_RuntimeMappingType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	field := mapping.RuntimeField("discounted", RuntimeFieldType.Double)
+
+	// Then
+	assert.IsTypeString(t, "es.RuntimeMappingType", field)
+	assert.Equal(t, "{\"discounted\":{\"type\":\"double\"}}", assert.MarshalWithoutError(t, field))
+}
+
+func Test_RuntimeField_should_create_json_with_script(t *testing.T) {
+	t.Parallel()
+	// Given
+	field := mapping.RuntimeField("day_of_week", RuntimeFieldType.Keyword).
+		Script(es.ScriptSource("emit(doc['date'].value.dayOfWeekEnum.toString())", ScriptLanguage.Painless))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, field)
+	assert.Equal(t,
+		"{\"day_of_week\":{\"script\":{\"lang\":\"painless\",\"source\":\"emit(doc['date'].value.dayOfWeekEnum.toString())\"},\"type\":\"keyword\"}}",
+		bodyJSON,
+	)
+}
+
+func Test_RuntimeField_should_create_json_for_composite_and_lookup_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	composite := mapping.RuntimeField("http", RuntimeFieldType.Composite).
+		Fields(mapping.RuntimeField("clientip", RuntimeFieldType.IP), mapping.RuntimeField("verb", RuntimeFieldType.Keyword))
+	lookup := mapping.RuntimeField("location", RuntimeFieldType.Lookup).
+		TargetIndex("ip_location").
+		InputField("host").
+		TargetField("ip").
+		FetchFields("country", "city")
+
+	// When Then
+	assert.Equal(t,
+		"{\"http\":{\"fields\":{\"clientip\":{\"type\":\"ip\"},\"verb\":{\"type\":\"keyword\"}},\"type\":\"composite\"}}",
+		assert.MarshalWithoutError(t, composite),
+	)
+	assert.Equal(t,
+		"{\"location\":{\"fetch_fields\":[\"country\",\"city\"],\"input_field\":\"host\",\"target_field\":\"ip\",\"target_index\":\"ip_location\",\"type\":\"lookup\"}}",
+		assert.MarshalWithoutError(t, lookup),
+	)
+}
@@ -0,0 +1,96 @@
+package mapping
+
+import (
+	"github.com/Trendyol/es-query-builder/es"
+)
+
+type settingsType es.Object
+
+// Settings creates an empty "settings" object of an index.
+//
+// Example usage:
+//
+//	s := mapping.Settings().
+//		NumberOfShards(1).
+//		NumberOfReplicas(1).
+//		Analysis(mapping.Analysis().Analyzer("autocomplete", mapping.CustomAnalyzer("standard")))
+//
+// Returns:
+//
+//	An empty mapping.settingsType object.
+func Settings() settingsType {
+	return settingsType{}
+}
+
+// NumberOfShards sets the number of primary shards of the index.
+//
+// Parameters:
+//   - numberOfShards: The number of primary shards.
+//
+// Returns:
+//
+//	The updated mapping.settingsType object with "index.number_of_shards" set.
+func (s settingsType) NumberOfShards(numberOfShards int) settingsType {
+	return putInTheField(s, "index", "number_of_shards", numberOfShards)
+}
+
+// NumberOfReplicas sets the number of replicas of each primary shard.
+//
+// Parameters:
+//   - numberOfReplicas: The number of replicas.
+//
+// Returns:
+//
+//	The updated mapping.settingsType object with "index.number_of_replicas" set.
+func (s settingsType) NumberOfReplicas(numberOfReplicas int) settingsType {
+	return putInTheField(s, "index", "number_of_replicas", numberOfReplicas)
+}
+
+// RefreshInterval sets how often changes are made visible to search.
+//
+// Parameters:
+//   - refreshInterval: A time value such as "1s", or "-1" to disable refreshes.
+//
+// Returns:
+//
+//	The updated mapping.settingsType object with "index.refresh_interval" set.
+func (s settingsType) RefreshInterval(refreshInterval string) settingsType {
+	return putInTheField(s, "index", "refresh_interval", refreshInterval)
+}
+
+// MaxResultWindow sets the maximum value of from + size for searches on the index.
+//
+// Parameters:
+//   - maxResultWindow: The maximum result window.
+//
+// Returns:
+//
+//	The updated mapping.settingsType object with "index.max_result_window" set.
+func (s settingsType) MaxResultWindow(maxResultWindow int) settingsType {
+	return putInTheField(s, "index", "max_result_window", maxResultWindow)
+}
+
+// MaxTermsCount sets the maximum number of terms a terms query may use.
+//
+// Parameters:
+//   - maxTermsCount: The maximum number of terms.
+//
+// Returns:
+//
+//	The updated mapping.settingsType object with "index.max_terms_count" set.
+func (s settingsType) MaxTermsCount(maxTermsCount int) settingsType {
+	return putInTheField(s, "index", "max_terms_count", maxTermsCount)
+}
+
+// Analysis sets the analyzers, normalizers, tokenizers and filters of the index.
+//
+// Parameters:
+//   - analysis: The mapping.analysisType object.
+//
+// Returns:
+//
+//	The updated mapping.settingsType object with the "analysis" field set.
+func (s settingsType) Analysis(analysis analysisType) settingsType {
+	s["analysis"] = analysis
+	return s
+}
@@ -0,0 +1,37 @@
+package mapping_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/mapping"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Settings   ////
+
+func Test_Settings_should_create_settingsType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	settings := mapping.Settings()
+
+	// Then
+	assert.IsTypeString(t, "mapping.settingsType", settings)
+	assert.Equal(t, "{}", assert.MarshalWithoutError(t, settings))
+}
+
+func Test_Settings_should_put_index_settings_under_index(t *testing.T) {
+	t.Parallel()
+	// Given
+	settings := mapping.Settings().
+		NumberOfShards(3).
+		NumberOfReplicas(2).
+		RefreshInterval("-1").
+		Analysis(mapping.Analysis())
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, settings)
+	assert.Equal(t,
+		"{\"analysis\":{},\"index\":{\"number_of_replicas\":2,\"number_of_shards\":3,\"refresh_interval\":\"-1\"}}",
+		bodyJSON,
+	)
+}
@@ -0,0 +1,191 @@
+package es
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	SearchType "github.com/Trendyol/es-query-builder/es/enums/search-type"
+)
+
+type multiSearchHeaderType Object
+
+type multiSearchEntry struct {
+	header multiSearchHeaderType
+	body   Object
+}
+
+type multiSearchType []multiSearchEntry
+
+// MultiSearch creates an empty multi search request, which batches several
+// searches into a single _msearch call.
+//
+// Example usage:
+//
+//	ms := es.MultiSearch().
+//		Add(es.MultiSearchHeader().Index("products"), es.NewQuery(es.Term("brand", "apple"))).
+//		Add(es.MultiSearchHeader().Index("reviews"), es.NewQuery(es.MatchAll()).Size(5))
+//	var body bytes.Buffer
+//	_, err := ms.WriteTo(&body)
+//	// body now holds the NDJSON request of the _msearch API.
+//
+// Returns:
+//
+//	An empty es.multiSearchType.
+func MultiSearch() multiSearchType {
+	return multiSearchType{}
+}
+
+// Add appends a search, made of a header line and a search body, to the
+// multi search request. Responses are returned in the order searches are added.
+// The receiver is not modified, so requests built from a shared prefix do not
+// overwrite each other.
+//
+// Example usage:
+//
+//	ms := es.MultiSearch().Add(es.MultiSearchHeader().Index("products"), es.NewQuery(es.MatchAll()))
+//
+// Parameters:
+//   - header: The es.multiSearchHeaderType with the target index and search options. A nil
+//     header is written as {} and targets the index of the _msearch URL.
+//   - body: The search body.
+//
+// Returns:
+//
+//	The updated es.multiSearchType.
+func (m multiSearchType) Add(header multiSearchHeaderType, body Object) multiSearchType {
+	entries := make(multiSearchType, len(m), len(m)+1)
+	copy(entries, m)
+	return append(entries, multiSearchEntry{header: header, body: body})
+}
+
+// Len returns the number of searches in the multi search request.
+//
+// Returns:
+//
+//	The number of searches added with es.multiSearchType.Add.
+func (m multiSearchType) Len() int {
+	return len(m)
+}
+
+// WriteTo writes the multi search request as newline-delimited JSON, a header
+// line followed by a body line for each search, so it implements io.WriterTo.
+//
+// Example usage:
+//
+//	var body bytes.Buffer
+//	if _, err := ms.WriteTo(&body); err != nil {
+//		return err
+//	}
+//	res, err := client.Msearch(&body)
+//
+// Parameters:
+//   - w: The io.Writer the request is written to.
+//
+// Returns:
+//
+//	The number of bytes written and the first marshaling or write error.
+func (m multiSearchType) WriteTo(w io.Writer) (int64, error) {
+	var total int64
+	var buf bytes.Buffer
+	for i := 0; i < len(m); i++ {
+		buf.Reset()
+		if err := writeNDJSONLine(&buf, m[i].header); err != nil {
+			return total, err
+		}
+		if err := writeNDJSONLine(&buf, m[i].body); err != nil {
+			return total, err
+		}
+		n, err := w.Write(buf.Bytes())
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// writeNDJSONLine writes value as a single JSON line, writing nil maps as {}.
+func writeNDJSONLine[T ~map[string]any](buf *bytes.Buffer, value T) error {
+	if value == nil {
+		buf.WriteString("{}\n")
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	buf.Write(data)
+	buf.WriteByte('\n')
+	return nil
+}
+
+// MultiSearchHeader creates an empty header line of a multi search request.
+//
+// Example usage:
+//
+//	h := es.MultiSearchHeader().Index("products").Routing("user-1")
+//	// h now contains {"index": "products", "routing": "user-1"}
+//
+// Returns:
+//
+//	An empty es.multiSearchHeaderType object.
+func MultiSearchHeader() multiSearchHeaderType {
+	return multiSearchHeaderType{}
+}
+
+// Index sets the indices, aliases or data streams the search targets.
+//
+// Parameters:
+//   - indices: One or more index names or patterns.
+//
+// Returns:
+//
+//	The updated es.multiSearchHeaderType object with the "index" field set.
+func (h multiSearchHeaderType) Index(indices ...string) multiSearchHeaderType {
+	if len(indices) == 1 {
+		h["index"] = indices[0]
+	} else {
+		h["index"] = indices
+	}
+	return h
+}
+
+// Routing sets the routing value used to select the shards to search.
+//
+// Parameters:
+//   - routing: A routing value, or several separated by commas.
+//
+// Returns:
+//
+//	The updated es.multiSearchHeaderType object with the "routing" field set.
+func (h multiSearchHeaderType) Routing(routing string) multiSearchHeaderType {
+	h["routing"] = routing
+	return h
+}
+
+// Preference sets the nodes and shards preferred to execute the search.
+//
+// Parameters:
+//   - preference: A preference such as "_local" or a custom string for consistent shard selection.
+//
+// Returns:
+//
+//	The updated es.multiSearchHeaderType object with the "preference" field set.
+func (h multiSearchHeaderType) Preference(preference string) multiSearchHeaderType {
+	h["preference"] = preference
+	return h
+}
+
+// SearchType sets how documents are scored across shards.
+//
+// Parameters:
+//   - searchType: A SearchType.SearchType value.
+//
+// Returns:
+//
+//	The updated es.multiSearchHeaderType object with the "search_type" field set.
+func (h multiSearchHeaderType) SearchType(searchType SearchType.SearchType) multiSearchHeaderType {
+	h["search_type"] = searchType
+	return h
+}
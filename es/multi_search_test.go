@@ -0,0 +1,118 @@
+package es_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	SearchType "github.com/Trendyol/es-query-builder/es/enums/search-type"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   MultiSearch   ////
+
+func Test_MultiSearch_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.MultiSearch)
+	assert.NotNil(t, es.MultiSearchHeader)
+}
+
+func Test_MultiSearch_should_create_multiSearchType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	multiSearch := es.MultiSearch()
+
+	// Then
+	assert.IsTypeString(t, "es.multiSearchType", multiSearch)
+	assert.IsTypeString(t, "es.multiSearchHeaderType", es.MultiSearchHeader())
+	assert.Equal(t, 0, multiSearch.Len())
+}
+
+func Test_MultiSearch_WriteTo_should_write_header_and_body_lines_in_order(t *testing.T) {
+	t.Parallel()
+	// Given
+	multiSearch := es.MultiSearch().
+		Add(
+			es.MultiSearchHeader().Index("products").Routing("user-1").Preference("_local").SearchType(SearchType.DfsQueryThenFetch),
+			es.NewQuery(es.Term("brand", "apple")).Size(10),
+		).
+		Add(es.MultiSearchHeader().Index("reviews", "reviews-archive"), es.NewQuery(es.MatchAll())).
+		Add(nil, nil)
+	var buf bytes.Buffer
+
+	// When
+	n, err := multiSearch.WriteTo(&buf)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 3, multiSearch.Len())
+	assert.Equal(t, int64(buf.Len()), n)
+	assert.Equal(t,
+		"{\"index\":\"products\",\"preference\":\"_local\",\"routing\":\"user-1\",\"search_type\":\"dfs_query_then_fetch\"}\n"+
+			"{\"query\":{\"term\":{\"brand\":{\"value\":\"apple\"}}},\"size\":10}\n"+
+			"{\"index\":[\"reviews\",\"reviews-archive\"]}\n"+
+			"{\"query\":{\"match_all\":{}}}\n"+
+			"{}\n"+
+			"{}\n",
+		buf.String(),
+	)
+}
+
+func Test_MultiSearch_Add_should_not_share_entries_between_requests(t *testing.T) {
+	t.Parallel()
+	// Given
+	prefix := es.MultiSearch().
+		Add(es.MultiSearchHeader().Index("products"), es.NewQuery(es.MatchAll())).
+		Add(es.MultiSearchHeader().Index("reviews"), es.NewQuery(es.MatchAll())).
+		Add(es.MultiSearchHeader().Index("orders"), es.NewQuery(es.MatchAll()))
+
+	// When
+	brands := prefix.Add(es.MultiSearchHeader().Index("brands"), nil)
+	sellers := prefix.Add(es.MultiSearchHeader().Index("sellers"), nil)
+
+	// Then
+	var brandsBody, sellersBody bytes.Buffer
+	_, brandsErr := brands.WriteTo(&brandsBody)
+	_, sellersErr := sellers.WriteTo(&sellersBody)
+	assert.Nil(t, brandsErr)
+	assert.Nil(t, sellersErr)
+	assert.Equal(t, 3, prefix.Len())
+	assert.True(t, bytes.Contains(brandsBody.Bytes(), []byte(`{"index":"brands"}`)))
+	assert.False(t, bytes.Contains(brandsBody.Bytes(), []byte(`{"index":"sellers"}`)))
+	assert.True(t, bytes.Contains(sellersBody.Bytes(), []byte(`{"index":"sellers"}`)))
+}
+
+type failingWriter struct {
+	written int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.written > 0 {
+		return 0, errors.New("connection reset")
+	}
+	w.written += len(p)
+	return len(p), nil
+}
+
+func Test_MultiSearch_WriteTo_should_return_write_and_marshal_errors(t *testing.T) {
+	t.Parallel()
+	// Given
+	multiSearch := es.MultiSearch().
+		Add(es.MultiSearchHeader(), es.NewQuery(es.MatchAll())).
+		Add(es.MultiSearchHeader(), es.NewQuery(es.MatchAll()))
+	invalid := es.MultiSearch().Add(es.MultiSearchHeader(), es.Object{"min_score": math.NaN()})
+	writer := &failingWriter{}
+
+	// When
+	n, writeErr := multiSearch.WriteTo(writer)
+	_, marshalErr := invalid.WriteTo(&bytes.Buffer{})
+
+	// Then
+	assert.Equal(t, "connection reset", writeErr.Error())
+	assert.Equal(t, int64(writer.written), n)
+	assert.NotNil(t, marshalErr)
+}
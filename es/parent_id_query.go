@@ -0,0 +1,52 @@
+package es
+
+type parentIDType Object
+
+// ParentID creates a new es.parentIDType object for a parent_id query, which
+// returns the child documents of a single parent document.
+//
+// Example usage:
+//
+//	p := es.ParentID("listing", "seller-42")
+//	// p now contains {"parent_id": {"id": "seller-42", "type": "listing"}}
+//
+// Parameters:
+//   - childType: The name of the child relation in the join field.
+//   - id: The _id of the parent document.
+//
+// Returns:
+//
+//	An es.parentIDType object with the "parent_id" query.
+func ParentID(childType, id string) parentIDType {
+	return parentIDType{
+		"parent_id": Object{
+			"type": childType,
+			"id":   id,
+		},
+	}
+}
+
+// IgnoreUnmapped sets whether the query matches no documents instead of failing
+// when the child type is not mapped.
+//
+// Parameters:
+//   - ignoreUnmapped: A boolean enabling or disabling ignore_unmapped.
+//
+// Returns:
+//
+//	The updated es.parentIDType object with the "ignore_unmapped" field set.
+func (p parentIDType) IgnoreUnmapped(ignoreUnmapped bool) parentIDType {
+	return genericPutInTheField(p, "parent_id", "ignore_unmapped", ignoreUnmapped)
+}
+
+// Boost sets the "boost" field in the parent_id query.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.parentIDType object with the "boost" field set.
+func (p parentIDType) Boost(boost float64) parentIDType {
+	return genericPutInTheField(p, "parent_id", "boost", boost)
+}
@@ -0,0 +1,37 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   ParentID   ////
+
+func Test_ParentID_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.ParentID)
+}
+
+func Test_ParentID_should_create_parentIDType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	parentID := es.ParentID("listing", "seller-42")
+
+	// Then
+	assert.IsTypeString(t, "es.parentIDType", parentID)
+	bodyJSON := assert.MarshalWithoutError(t, parentID)
+	assert.Equal(t, "{\"parent_id\":{\"id\":\"seller-42\",\"type\":\"listing\"}}", bodyJSON)
+}
+
+func Test_ParentID_should_create_json_with_ignore_unmapped_and_boost(t *testing.T) {
+	t.Parallel()
+	// Given
+	parentID := es.ParentID("listing", "seller-42").IgnoreUnmapped(true).Boost(0.5)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, parentID)
+	assert.Equal(t, "{\"parent_id\":{\"boost\":0.5,\"id\":\"seller-42\",\"ignore_unmapped\":true,\"type\":\"listing\"}}", bodyJSON)
+}
@@ -0,0 +1,837 @@
+package es
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrUnknownClause is returned by es.ParseQuery when the body contains a query
+// or aggregation clause that the es package cannot reconstruct.
+var ErrUnknownClause = errors.New("unknown clause")
+
+type clauseParser func(name string, body Object, path string) (any, error)
+
+var (
+	queryParsers       map[string]clauseParser
+	aggregationParsers map[string]clauseParser
+)
+
+func init() {
+	queryParsers = map[string]clauseParser{
+		"bool":                parseBoolClause,
+		"term":                parseFieldClause("value", func(o Object) any { return termType(o) }),
+		"terms":               wrapClause(func(o Object) any { return termsType(o) }),
+		"terms_set":           parseTermsSetClause,
+		"range":               wrapClause(func(o Object) any { return rangeType(o) }),
+		"exists":              wrapClause(func(o Object) any { return existsType(o) }),
+		"ids":                 wrapClause(func(o Object) any { return idsType(o) }),
+		"prefix":              parseFieldClause("value", func(o Object) any { return prefixType(o) }),
+		"wildcard":            parseFieldClause("value", func(o Object) any { return wildcardType(o) }),
+		"regexp":              parseFieldClause("value", func(o Object) any { return regexpType(o) }),
+		"fuzzy":               parseFieldClause("value", func(o Object) any { return fuzzyType(o) }),
+		"match":               parseFieldClause("query", func(o Object) any { return matchType(o) }),
+		"match_phrase":        parseFieldClause("query", func(o Object) any { return matchPhraseType(o) }),
+		"match_phrase_prefix": parseFieldClause("query", func(o Object) any { return matchPhrasePrefixType(o) }),
+		"match_bool_prefix":   parseFieldClause("query", func(o Object) any { return matchBoolPrefixType(o) }),
+		"match_all":           wrapClause(func(o Object) any { return matchAllType(o) }),
+		"match_none":          wrapClause(func(o Object) any { return matchNoneType(o) }),
+		"multi_match":         wrapClause(func(o Object) any { return multiMatchType(o) }),
+		"query_string":        wrapClause(func(o Object) any { return queryStringType(o) }),
+		"simple_query_string": wrapClause(func(o Object) any { return simpleQueryStringType(o) }),
+		"geo_distance":        wrapClause(func(o Object) any { return geoDistanceType(o) }),
+		"geo_bounding_box":    wrapClause(func(o Object) any { return geoBoundingBoxType(o) }),
+		"script":              parseScriptClause,
+		"nested":              parseNestedClause,
+		"knn":                 parseKnnClause,
+		"has_child":           parseJoinClause(func(o Object) any { return hasChildType(o) }),
+		"has_parent":          parseJoinClause(func(o Object) any { return hasParentType(o) }),
+		"parent_id":           wrapClause(func(o Object) any { return parentIDType(o) }),
+		"span_term":           parseFieldClause("value", func(o Object) any { return spanQueryType(o) }),
+		"span_multi":          parseSpanMultiClause,
+		"span_near":           parseSpanClause,
+		"span_or":             parseSpanClause,
+		"span_not":            parseSpanClause,
+		"span_first":          parseSpanClause,
+		"span_containing":     parseSpanClause,
+		"span_within":         parseSpanClause,
+		"field_masking_span":  parseSpanClause,
+		"sparse_vector":       wrapClause(func(o Object) any { return sparseVectorType(o) }),
+		"semantic":            wrapClause(func(o Object) any { return semanticType(o) }),
+		"text_expansion":      wrapClause(func(o Object) any { return textExpansionType(o) }),
+		"weighted_tokens":     wrapClause(func(o Object) any { return weightedTokensType(o) }),
+		"constant_score":      parseConstantScoreClause,
+		"dis_max":             parseDisMaxClause,
+		"function_score":      parseFunctionScoreClause,
+	}
+	aggregationParsers = map[string]clauseParser{
+		"terms":          parseOrderedAggregation(func(o Object) any { return termsAggType(o) }),
+		"multi_terms":    parseMultiTermsAggregation,
+		"histogram":      parseOrderedAggregation(func(o Object) any { return histogramAggType(o) }),
+		"date_histogram": parseOrderedAggregation(func(o Object) any { return dateHistogramAggType(o) }),
+		"range":          parseRangeAggregation,
+		"date_range":     parseDateRangeAggregation,
+		"filter":         parseFilterAggregation,
+		"filters":        parseFiltersAggregation,
+		"nested":         parseMetricAggregation(func(o Object) any { return nestedAggType(o) }),
+		"reverse_nested": parseMetricAggregation(func(o Object) any { return reverseNestedAggType(o) }),
+		"children":       parseMetricAggregation(func(o Object) any { return childrenAggType(o) }),
+		"parent":         parseMetricAggregation(func(o Object) any { return parentAggType(o) }),
+		"top_hits":       parseTopHitsAggregation,
+		"avg":            parseMetricAggregation(func(o Object) any { return avgAggType(o) }),
+		"min":            parseMetricAggregation(func(o Object) any { return minAggType(o) }),
+		"max":            parseMetricAggregation(func(o Object) any { return maxAggType(o) }),
+		"sum":            parseMetricAggregation(func(o Object) any { return sumAggType(o) }),
+		"stats":          parseMetricAggregation(func(o Object) any { return statsAggType(o) }),
+		"extended_stats": parseMetricAggregation(func(o Object) any { return extendedStatsAggType(o) }),
+		"cardinality":    parseMetricAggregation(func(o Object) any { return cardinalityAggType(o) }),
+		"value_count":    parseMetricAggregation(func(o Object) any { return valueCountAggType(o) }),
+	}
+}
+
+// ParseQuery parses a raw Elasticsearch search body back into es builder types.
+//
+// Every known clause is reconstructed as the type its builder produces (es.BoolType with
+// es.FilterType/es.MustType arrays, term, range, aggregation and sort types...), so builder
+// methods such as es.BoolType.Filter or es.Object.Sort keep working on the loaded query.
+// Short forms accepted by Elasticsearch, like {"term": {"field": "value"}}, are normalized
+// into the long form the builders produce. Numbers are kept as json.Number to preserve precision.
+//
+// Example usage:
+//
+//	query, err := es.ParseQuery(savedSearch)
+//	if err != nil {
+//		return err
+//	}
+//	query["query"].(es.Object)["bool"].(es.BoolType).Filter(es.Term("tenant", tenantID))
+//	query.Sort(es.Sort("date").Order(Order.Desc))
+//
+// Parameters:
+//   - data: The raw JSON search body.
+//
+// Returns:
+//
+//	The reconstructed es.Object, or an error naming the path of the first invalid
+//	or unknown clause. Unknown clauses wrap es.ErrUnknownClause.
+func ParseQuery(data []byte) (Object, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var body any
+	if err := decoder.Decode(&body); err != nil {
+		return nil, fmt.Errorf("invalid search body: %w", err)
+	}
+	root, ok := toObject(body)
+	if !ok {
+		return nil, errors.New("invalid search body: expected a JSON object")
+	}
+	return parseRoot(root)
+}
+
+func parseRoot(root Object) (Object, error) {
+	for key, value := range root {
+		var (
+			parsed any
+			err    error
+		)
+		switch key {
+		case "query", "post_filter":
+			parsed, err = parseQueryClause(value, key)
+		case "aggs", "aggregations":
+			parsed, err = parseAggregations(value, key)
+		case "sort":
+			parsed, err = parseSorts(value, key)
+		case "highlight":
+			parsed, err = parseHighlight(value, key)
+		case "collapse":
+			parsed, err = parseFieldCollapse(value, key)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		root[key] = parsed
+	}
+	normalizeAggregationsKey(root)
+	return root, nil
+}
+
+// normalizeAggregationsKey moves parsed aggregations under "aggregations" to
+// "aggs", the key the builders use, so a later Aggs call does not add a second key.
+func normalizeAggregationsKey(object Object) {
+	aggregations, ok := object["aggregations"].(Object)
+	if !ok {
+		return
+	}
+	delete(object, "aggregations")
+	aggs, ok := object["aggs"].(Object)
+	if !ok {
+		object["aggs"] = aggregations
+		return
+	}
+	for name, agg := range aggregations {
+		aggs[name] = agg
+	}
+}
+
+func parseQueryClause(value any, path string) (any, error) {
+	clause, ok := value.(Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a query clause object", path)
+	}
+	if len(clause) == 0 {
+		return clause, nil
+	}
+	if len(clause) != 1 {
+		return nil, fmt.Errorf("%s: expected a single query clause, got %d keys", path, len(clause))
+	}
+	for name, rawBody := range clause {
+		clausePath := path + "." + name
+		parser, exists := queryParsers[name]
+		if !exists {
+			return nil, fmt.Errorf("%s: %w %q", path, ErrUnknownClause, name)
+		}
+		body, isObject := rawBody.(Object)
+		if !isObject {
+			return nil, fmt.Errorf("%s: expected an object", clausePath)
+		}
+		parsed, err := parser(name, body, clausePath)
+		if err != nil {
+			return nil, err
+		}
+		if boolType, isBool := parsed.(BoolType); isBool {
+			return Object{"bool": boolType}, nil
+		}
+		return parsed, nil
+	}
+	return nil, nil
+}
+
+func parseQueryClauses(value any, path string) (Array, error) {
+	items, ok := value.(Array)
+	if !ok {
+		items = Array{value}
+	}
+	clauses := make(Array, 0, len(items))
+	for i := 0; i < len(items); i++ {
+		clause, err := parseQueryClause(items[i], fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, clause)
+	}
+	return clauses, nil
+}
+
+func wrapClause(wrap func(Object) any) clauseParser {
+	return func(name string, body Object, _ string) (any, error) {
+		return wrap(Object{name: body}), nil
+	}
+}
+
+func parseFieldClause(valueKey string, wrap func(Object) any) clauseParser {
+	return func(name string, body Object, _ string) (any, error) {
+		for field, value := range body {
+			if _, ok := value.(Object); !ok {
+				body[field] = Object{valueKey: value}
+			}
+		}
+		return wrap(Object{name: body}), nil
+	}
+}
+
+func parseBoolClause(_ string, body Object, path string) (any, error) {
+	b := BoolType{}
+	for key, value := range body {
+		if key != "filter" && key != "must" && key != "must_not" && key != "should" {
+			b[key] = value
+			continue
+		}
+		clauses, err := parseQueryClauses(value, path+"."+key)
+		if err != nil {
+			return nil, err
+		}
+		switch key {
+		case "filter":
+			b[key] = FilterType(clauses)
+		case "must":
+			b[key] = MustType(clauses)
+		case "must_not":
+			b[key] = MustNotType(clauses)
+		default:
+			b[key] = ShouldType(clauses)
+		}
+	}
+	return b, nil
+}
+
+func parseTermsSetClause(_ string, body Object, _ string) (any, error) {
+	for _, value := range body {
+		if field, ok := value.(Object); ok {
+			if script, sOk := field["minimum_should_match_script"].(Object); sOk {
+				field["minimum_should_match_script"] = scriptType(script)
+			}
+		}
+	}
+	return termsSetType{"terms_set": body}, nil
+}
+
+func parseScriptClause(_ string, body Object, _ string) (any, error) {
+	if script, ok := body["script"].(Object); ok {
+		body["script"] = scriptType(script)
+	}
+	return scriptQueryType{"script": body}, nil
+}
+
+func parseNestedClause(_ string, body Object, path string) (any, error) {
+	if query, ok := body["query"]; ok {
+		parsed, err := parseQueryClause(query, path+".query")
+		if err != nil {
+			return nil, err
+		}
+		body["query"] = parsed
+	}
+	if innerHits, ok := body["inner_hits"]; ok {
+		parsed, err := parseInnerHits(innerHits, path+".inner_hits")
+		if err != nil {
+			return nil, err
+		}
+		body["inner_hits"] = parsed
+	}
+	return nestedType{"nested": body}, nil
+}
+
+func parseJoinClause(wrap func(Object) any) clauseParser {
+	return func(name string, body Object, path string) (any, error) {
+		if query, ok := body["query"]; ok {
+			parsed, err := parseQueryClause(query, path+".query")
+			if err != nil {
+				return nil, err
+			}
+			body["query"] = parsed
+		}
+		if innerHits, ok := body["inner_hits"]; ok {
+			parsed, err := parseInnerHits(innerHits, path+".inner_hits")
+			if err != nil {
+				return nil, err
+			}
+			body["inner_hits"] = parsed
+		}
+		return wrap(Object{name: body}), nil
+	}
+}
+
+func parseSpanMultiClause(name string, body Object, path string) (any, error) {
+	match, err := parseQueryClause(body["match"], path+".match")
+	if err != nil {
+		return nil, err
+	}
+	switch match.(type) {
+	case prefixType, wildcardType, regexpType, fuzzyType, rangeType:
+		body["match"] = match
+		return spanQueryType{name: body}, nil
+	}
+	return nil, fmt.Errorf("%s.match: expected a prefix, wildcard, regexp, fuzzy or range query", path)
+}
+
+func parseSpanClause(name string, body Object, path string) (any, error) {
+	for _, key := range []string{"include", "exclude", "match", "big", "little", "query"} {
+		if value, ok := body[key]; ok {
+			parsed, err := parseSpanQuery(value, path+"."+key)
+			if err != nil {
+				return nil, err
+			}
+			body[key] = parsed
+		}
+	}
+	if value, ok := body["clauses"]; ok {
+		items, isArray := value.(Array)
+		if !isArray {
+			return nil, fmt.Errorf("%s.clauses: expected an array", path)
+		}
+		clauses := make([]spanClause, 0, len(items))
+		for i := 0; i < len(items); i++ {
+			parsed, err := parseSpanQuery(items[i], fmt.Sprintf("%s.clauses[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, parsed)
+		}
+		body["clauses"] = clauses
+	}
+	switch name {
+	case "span_near":
+		return spanNearType{name: body}, nil
+	case "span_not":
+		return spanNotType{name: body}, nil
+	}
+	return spanQueryType{name: body}, nil
+}
+
+func parseSpanQuery(value any, path string) (spanClause, error) {
+	parsed, err := parseQueryClause(value, path)
+	if err != nil {
+		return nil, err
+	}
+	span, ok := parsed.(spanClause)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a span query", path)
+	}
+	return span, nil
+}
+
+func parseKnnClause(_ string, body Object, path string) (any, error) {
+	if filter, ok := body["filter"]; ok {
+		parsed, err := parseQueryClauses(filter, path+".filter")
+		if err != nil {
+			return nil, err
+		}
+		body["filter"] = parsed
+	}
+	if innerHits, ok := body["inner_hits"]; ok {
+		parsed, err := parseInnerHits(innerHits, path+".inner_hits")
+		if err != nil {
+			return nil, err
+		}
+		body["inner_hits"] = parsed
+	}
+	return knnType{"knn": body}, nil
+}
+
+func parseConstantScoreClause(_ string, body Object, path string) (any, error) {
+	filter, err := parseQueryClause(body["filter"], path+".filter")
+	if err != nil {
+		return nil, err
+	}
+	body["filter"] = filter
+	return constantScoreType{"constant_score": body}, nil
+}
+
+func parseDisMaxClause(_ string, body Object, path string) (any, error) {
+	queries, err := parseQueryClauses(body["queries"], path+".queries")
+	if err != nil {
+		return nil, err
+	}
+	body["queries"] = queries
+	return disMaxType{"dis_max": body}, nil
+}
+
+func parseFunctionScoreClause(_ string, body Object, path string) (any, error) {
+	if query, ok := body["query"]; ok {
+		parsed, err := parseQueryClause(query, path+".query")
+		if err != nil {
+			return nil, err
+		}
+		body["query"] = parsed
+	}
+	parseScriptScore(body)
+	if functions, ok := body["functions"].(Array); ok {
+		parsed := make(Array, 0, len(functions))
+		for i := 0; i < len(functions); i++ {
+			function, isObject := functions[i].(Object)
+			if !isObject {
+				return nil, fmt.Errorf("%s.functions[%d]: expected an object", path, i)
+			}
+			if filter, hasFilter := function["filter"]; hasFilter {
+				parsedFilter, err := parseQueryClause(filter, fmt.Sprintf("%s.functions[%d].filter", path, i))
+				if err != nil {
+					return nil, err
+				}
+				function["filter"] = parsedFilter
+			}
+			parseScriptScore(function)
+			parsed = append(parsed, functionScoreFunction(function))
+		}
+		body["functions"] = parsed
+	}
+	return functionScoreType{"function_score": body}, nil
+}
+
+func parseScriptScore(o Object) {
+	if scriptScore, ok := o["script_score"].(Object); ok {
+		if script, sOk := scriptScore["script"].(Object); sOk {
+			scriptScore["script"] = scriptType(script)
+		}
+	}
+}
+
+func parseInnerHits(value any, path string) (innerHitsType, error) {
+	o, ok := value.(Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object", path)
+	}
+	if sorts, exists := o["sort"]; exists {
+		parsed, err := parseSorts(sorts, path+".sort")
+		if err != nil {
+			return nil, err
+		}
+		o["sort"] = parsed
+	}
+	if collapse, exists := o["collapse"]; exists {
+		parsed, err := parseFieldCollapse(collapse, path+".collapse")
+		if err != nil {
+			return nil, err
+		}
+		o["collapse"] = parsed
+	}
+	if scriptFields, exists := o["script_fields"].(Object); exists {
+		o["script_fields"] = parseScriptFields(scriptFields)
+	}
+	return innerHitsType(o), nil
+}
+
+func parseFieldCollapse(value any, path string) (fieldCollapseType, error) {
+	o, ok := value.(Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object", path)
+	}
+	if innerHits, exists := o["inner_hits"]; exists {
+		items, isArray := innerHits.(Array)
+		if !isArray {
+			items = Array{innerHits}
+		}
+		parsed := make([]innerHitsType, 0, len(items))
+		for i := 0; i < len(items); i++ {
+			ih, err := parseInnerHits(items[i], fmt.Sprintf("%s.inner_hits[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			parsed = append(parsed, ih)
+		}
+		o["inner_hits"] = parsed
+	}
+	if collapse, exists := o["collapse"]; exists {
+		parsed, err := parseFieldCollapse(collapse, path+".collapse")
+		if err != nil {
+			return nil, err
+		}
+		o["collapse"] = parsed
+	}
+	return fieldCollapseType(o), nil
+}
+
+func parseScriptFields(o Object) scriptFieldsType {
+	scriptFields := scriptFieldsType{}
+	for name, value := range o {
+		scriptField, _ := value.(Object)
+		if script, ok := scriptField["script"].(Object); ok {
+			scriptField["script"] = scriptType(script)
+		}
+		scriptFields[name] = scriptFieldType(scriptField)
+	}
+	return scriptFields
+}
+
+func parseSorts(value any, path string) ([]sortType, error) {
+	items, ok := value.(Array)
+	if !ok {
+		items = Array{value}
+	}
+	sorts := make([]sortType, 0, len(items))
+	for i := 0; i < len(items); i++ {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		switch item := items[i].(type) {
+		case string:
+			sorts = append(sorts, Sort(item))
+		case Object:
+			s := sortType{}
+			for field, options := range item {
+				switch o := options.(type) {
+				case string:
+					s[field] = Object{"order": o}
+				case Object:
+					if nested, exists := o["nested"]; exists {
+						parsed, err := parseNestedSort(nested, itemPath+"."+field+".nested")
+						if err != nil {
+							return nil, err
+						}
+						o["nested"] = parsed
+					}
+					s[field] = o
+				default:
+					return nil, fmt.Errorf("%s.%s: expected an order or sort options", itemPath, field)
+				}
+			}
+			sorts = append(sorts, s)
+		default:
+			return nil, fmt.Errorf("%s: expected a field name or sort object", itemPath)
+		}
+	}
+	return sorts, nil
+}
+
+func parseNestedSort(value any, path string) (nestedSortType, error) {
+	o, ok := value.(Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object", path)
+	}
+	if filter, exists := o["filter"]; exists {
+		parsed, err := parseQueryClause(filter, path+".filter")
+		if err != nil {
+			return nil, err
+		}
+		o["filter"] = parsed
+	}
+	if nested, exists := o["nested"]; exists {
+		parsed, err := parseNestedSort(nested, path+".nested")
+		if err != nil {
+			return nil, err
+		}
+		o["nested"] = parsed
+	}
+	return nestedSortType(o), nil
+}
+
+func parseHighlight(value any, path string) (highlightType, error) {
+	o, ok := value.(Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object", path)
+	}
+	if query, exists := o["highlight_query"]; exists {
+		parsed, err := parseQueryClause(query, path+".highlight_query")
+		if err != nil {
+			return nil, err
+		}
+		o["highlight_query"] = parsed
+	}
+	fields, isObject := o["fields"].(Object)
+	if !isObject {
+		return highlightType(o), nil
+	}
+	for name, options := range fields {
+		field, fieldOk := options.(Object)
+		if !fieldOk {
+			continue
+		}
+		if query, exists := field["highlight_query"]; exists {
+			parsed, err := parseQueryClause(query, path+".fields."+name+".highlight_query")
+			if err != nil {
+				return nil, err
+			}
+			field["highlight_query"] = parsed
+		}
+	}
+	return highlightType(o), nil
+}
+
+func parseAggregations(value any, path string) (Object, error) {
+	aggs, ok := value.(Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object", path)
+	}
+	for name, definition := range aggs {
+		parsed, err := parseAggregation(definition, path+"."+name)
+		if err != nil {
+			return nil, err
+		}
+		aggs[name] = parsed
+	}
+	return aggs, nil
+}
+
+func parseAggregation(value any, path string) (any, error) {
+	agg, ok := value.(Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an aggregation object", path)
+	}
+	aggregationType := ""
+	for _, key := range sortedKeys(agg) {
+		switch key {
+		case "aggs", "aggregations":
+			parsed, err := parseAggregations(agg[key], path+"."+key)
+			if err != nil {
+				return nil, err
+			}
+			agg[key] = parsed
+		case "meta":
+		default:
+			if aggregationType != "" {
+				return nil, fmt.Errorf("%s: expected a single aggregation type, got %q and %q", path, aggregationType, key)
+			}
+			aggregationType = key
+		}
+	}
+	normalizeAggregationsKey(agg)
+	if aggregationType == "" {
+		return nil, fmt.Errorf("%s: missing aggregation type", path)
+	}
+	parser, exists := aggregationParsers[aggregationType]
+	if !exists {
+		return nil, fmt.Errorf("%s: %w %q", path, ErrUnknownClause, aggregationType)
+	}
+	return parser(aggregationType, agg, path+"."+aggregationType)
+}
+
+func parseMetricAggregation(wrap func(Object) any) clauseParser {
+	return func(name string, agg Object, _ string) (any, error) {
+		if body, ok := agg[name].(Object); ok {
+			if script, sOk := body["script"].(Object); sOk {
+				body["script"] = scriptType(script)
+			}
+		}
+		return wrap(agg), nil
+	}
+}
+
+func parseOrderedAggregation(wrap func(Object) any) clauseParser {
+	return func(name string, agg Object, path string) (any, error) {
+		body, ok := agg[name].(Object)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected an object", path)
+		}
+		if order, exists := body["order"]; exists {
+			items, isArray := order.(Array)
+			if !isArray {
+				items = Array{order}
+			}
+			orders := make([]aggOrder, 0, len(items))
+			for i := 0; i < len(items); i++ {
+				o, isObject := items[i].(Object)
+				if !isObject {
+					return nil, fmt.Errorf("%s.order[%d]: expected an object", path, i)
+				}
+				orders = append(orders, aggOrder(o))
+			}
+			body["order"] = orders
+		}
+		return parseMetricAggregation(wrap)(name, agg, path)
+	}
+}
+
+func parseMultiTermsAggregation(_ string, agg Object, path string) (any, error) {
+	body, ok := agg["multi_terms"].(Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object", path)
+	}
+	if terms, exists := body["terms"].(Array); exists {
+		parsed := make([]termAggType, 0, len(terms))
+		for i := 0; i < len(terms); i++ {
+			term, isObject := terms[i].(Object)
+			if !isObject {
+				return nil, fmt.Errorf("%s.terms[%d]: expected an object", path, i)
+			}
+			parsed = append(parsed, termAggType(term))
+		}
+		body["terms"] = parsed
+	}
+	return parseOrderedAggregation(func(o Object) any { return multiTermsAggType(o) })("multi_terms", agg, path)
+}
+
+func parseRangeAggregation(_ string, agg Object, _ string) (any, error) {
+	if body, ok := agg["range"].(Object); ok {
+		if ranges, exists := body["ranges"].(Array); exists {
+			entries := make([]rangeAggEntry, 0, len(ranges))
+			for i := 0; i < len(ranges); i++ {
+				entry, _ := ranges[i].(Object)
+				entries = append(entries, rangeAggEntry(entry))
+			}
+			body["ranges"] = entries
+		}
+	}
+	return rangeAggType(agg), nil
+}
+
+func parseDateRangeAggregation(_ string, agg Object, _ string) (any, error) {
+	if body, ok := agg["date_range"].(Object); ok {
+		if ranges, exists := body["ranges"].(Array); exists {
+			entries := make([]dateRangeAggEntry, 0, len(ranges))
+			for i := 0; i < len(ranges); i++ {
+				entry, _ := ranges[i].(Object)
+				entries = append(entries, dateRangeAggEntry(entry))
+			}
+			body["ranges"] = entries
+		}
+	}
+	return dateRangeAggType(agg), nil
+}
+
+func parseFilterAggregation(_ string, agg Object, path string) (any, error) {
+	filter, err := parseQueryClause(agg["filter"], path)
+	if err != nil {
+		return nil, err
+	}
+	agg["filter"] = filter
+	return filterAggType(agg), nil
+}
+
+func parseFiltersAggregation(_ string, agg Object, path string) (any, error) {
+	body, ok := agg["filters"].(Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object", path)
+	}
+	switch filters := body["filters"].(type) {
+	case Object:
+		for name, filter := range filters {
+			parsed, err := parseQueryClause(filter, path+".filters."+name)
+			if err != nil {
+				return nil, err
+			}
+			filters[name] = parsed
+		}
+	case Array:
+		parsed, err := parseQueryClauses(filters, path+".filters")
+		if err != nil {
+			return nil, err
+		}
+		body["filters"] = parsed
+	}
+	return filtersAggType(agg), nil
+}
+
+func parseTopHitsAggregation(_ string, agg Object, path string) (any, error) {
+	body, ok := agg["top_hits"].(Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object", path)
+	}
+	if sorts, exists := body["sort"]; exists {
+		parsed, err := parseSorts(sorts, path+".sort")
+		if err != nil {
+			return nil, err
+		}
+		body["sort"] = parsed
+	}
+	if highlight, exists := body["highlight"]; exists {
+		parsed, err := parseHighlight(highlight, path+".highlight")
+		if err != nil {
+			return nil, err
+		}
+		body["highlight"] = parsed
+	}
+	return topHitsAggType(agg), nil
+}
+
+// toObject recursively converts decoded JSON maps and slices into es.Object and es.Array.
+func toObject(value any) (Object, bool) {
+	o, ok := normalizeJSON(value).(Object)
+	return o, ok
+}
+
+func normalizeJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		o := make(Object, len(v))
+		for key, item := range v {
+			o[key] = normalizeJSON(item)
+		}
+		return o
+	case []any:
+		a := make(Array, 0, len(v))
+		for i := 0; i < len(v); i++ {
+			a = append(a, normalizeJSON(v[i]))
+		}
+		return a
+	default:
+		return v
+	}
+}
+
+func sortedKeys(o Object) []string {
+	keys := make([]string, 0, len(o))
+	for key := range o {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
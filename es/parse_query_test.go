@@ -0,0 +1,372 @@
+package es_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	ScoreMode "github.com/Trendyol/es-query-builder/es/enums/score-mode"
+	ScriptLanguage "github.com/Trendyol/es-query-builder/es/enums/script-language"
+	Order "github.com/Trendyol/es-query-builder/es/enums/sort/order"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   ParseQuery   ////
+
+func Test_ParseQuery_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.ParseQuery)
+}
+
+func Test_ParseQuery_should_round_trip_builder_output(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(
+		es.Bool().
+			Filter(
+				es.Term("brand", "apple"),
+				es.Terms("color", "red", "blue"),
+				es.Range("price").GreaterThanOrEqual(10).LessThan(100),
+				es.Exists("stock"),
+				es.Nested("variants", es.Match("variants.name", "pro")).
+					ScoreMode(ScoreMode.Max).
+					InnerHits(es.InnerHits().Size(3).Sort(es.Sort("variants.price").Order(Order.Asc))),
+			).
+			Must(
+				es.FunctionScore(es.MultiMatch("phone").Fields("title", "description")).
+					Functions(es.WeightFunction(2).Filter(es.Prefix("title", "iph"))),
+			).
+			MustNot(es.Bool().Should(es.IDs("1", "2"), es.Wildcard("sku", "x*"))).
+			Should(es.DisMax(es.MatchPhrase("title", "smart phone"), es.ConstantScore(es.MatchAll()))).
+			MinimumShouldMatch(1),
+	).
+		Size(20).
+		From(40).
+		SourceIncludes("title", "price").
+		Sort(es.Sort("date").Order(Order.Desc)).
+		Highlight(es.Highlight().Field(es.HighlightField("title"))).
+		PostFilter(es.Term("active", true)).
+		Aggs(
+			es.Agg("by_brand", es.TermsAgg("brand").Size(10).
+				Order(es.AggOrder("_count", Order.Desc)).
+				Aggs(es.Agg("avg_price", es.AvgAgg("price")))),
+			es.Agg("prices", es.RangeAgg("price").Range(es.RangeEntry().To(50))),
+			es.Agg("active", es.FilterAgg(es.Term("active", true))),
+			es.Agg("kinds", es.FiltersAgg().Filter("cheap", es.Range("price").LessThan(10))),
+			es.Agg("variants", es.NestedAgg("variants").Aggs(es.Agg("colors", es.MultiTermsAgg(es.TermAgg("variants.color"))))),
+			es.Agg("latest", es.TopHitsAgg().Size(1).Sort(es.Sort("date").Order(Order.Desc))),
+			es.Agg("per_day", es.DateHistogramAgg("date").CalendarInterval("day")),
+		)
+	expected := assert.MarshalWithoutError(t, query)
+
+	// When
+	parsed, err := es.ParseQuery([]byte(expected))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, expected, assert.MarshalWithoutError(t, parsed))
+}
+
+func Test_ParseQuery_should_reconstruct_BoolType_so_builder_methods_keep_working(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"bool":{"filter":[{"term":{"brand":{"value":"apple"}}}]}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+	boolQuery := parsed["query"].(es.Object)["bool"].(es.BoolType)
+	boolQuery.Filter(es.Exists("stock"))
+	parsed.Sort(es.Sort("date").Order(Order.Desc))
+
+	// Then
+	assert.Nil(t, err)
+	assert.IsTypeString(t, "es.FilterType", boolQuery["filter"])
+	bodyJSON := assert.MarshalWithoutError(t, parsed)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"bool\":{\"filter\":[{\"term\":{\"brand\":{\"value\":\"apple\"}}},{\"exists\":{\"field\":\"stock\"}}]}},\"sort\":[{\"date\":{\"order\":\"desc\"}}]}", bodyJSON)
+}
+
+func Test_ParseQuery_should_reconstruct_typed_clauses(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"bool":{"must":[{"range":{"price":{"gte":10}}}],"must_not":{"term":{"a":"b"}},"should":[]}},
+		"aggs":{"by_brand":{"terms":{"field":"brand"}}},"sort":["_score"]}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	boolQuery := parsed["query"].(es.Object)["bool"].(es.BoolType)
+	assert.IsTypeString(t, "es.MustType", boolQuery["must"])
+	assert.IsTypeString(t, "es.MustNotType", boolQuery["must_not"])
+	assert.IsTypeString(t, "es.ShouldType", boolQuery["should"])
+	assert.IsTypeString(t, "es.rangeType", boolQuery["must"].(es.MustType)[0])
+	assert.IsTypeString(t, "es.termsAggType", parsed["aggs"].(es.Object)["by_brand"])
+	assert.IsTypeString(t, "[]es.sortType", parsed["sort"])
+}
+
+func Test_ParseQuery_should_reconstruct_root_collapse(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"match_all":{}},"collapse":{"field":"seller_id","inner_hits":{"name":"cheapest","size":3}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	assert.IsTypeString(t, "es.fieldCollapseType", parsed["collapse"])
+	bodyJSON := assert.MarshalWithoutError(t, parsed)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"collapse\":{\"field\":\"seller_id\",\"inner_hits\":[{\"name\":\"cheapest\",\"size\":3}]},\"query\":{\"match_all\":{}}}", bodyJSON)
+}
+
+func Test_ParseQuery_should_reconstruct_knn_query(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"bool":{"should":[{"knn":{"field":"v","query_vector":[1,2],"k":5,"filter":{"term":{"brand":"acme"}}}}]}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	knn := parsed["query"].(es.Object)["bool"].(es.BoolType)["should"].(es.ShouldType)[0]
+	assert.IsTypeString(t, "es.knnType", knn)
+	bodyJSON := assert.MarshalWithoutError(t, parsed)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"bool\":{\"should\":[{\"knn\":{\"field\":\"v\",\"filter\":[{\"term\":{\"brand\":{\"value\":\"acme\"}}}],\"k\":5,\"query_vector\":[1,2]}}]}}}", bodyJSON)
+}
+
+func Test_ParseQuery_should_reconstruct_sparse_and_semantic_queries(t *testing.T) {
+	t.Parallel()
+	// Given
+	// nolint:golint,lll
+	body := `{"query":{"bool":{"should":[{"sparse_vector":{"field":"e","query":"q","inference_id":"elser"}},{"semantic":{"field":"s","query":"q"}},{"text_expansion":{"e":{"model_id":"m","model_text":"q"}}},{"weighted_tokens":{"e":{"tokens":{"a":1}}}}]}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	should := parsed["query"].(es.Object)["bool"].(es.BoolType)["should"].(es.ShouldType)
+	assert.IsTypeString(t, "es.sparseVectorType", should[0])
+	assert.IsTypeString(t, "es.semanticType", should[1])
+	assert.IsTypeString(t, "es.textExpansionType", should[2])
+	assert.IsTypeString(t, "es.weightedTokensType", should[3])
+}
+
+func Test_ParseQuery_should_reconstruct_parent_join_clauses(t *testing.T) {
+	t.Parallel()
+	// Given
+	// nolint:golint,lll
+	body := `{"query":{"bool":{"should":[{"has_child":{"type":"listing","query":{"match_all":{}},"inner_hits":{}}},{"has_parent":{"parent_type":"seller","query":{"match_all":{}}}},{"parent_id":{"type":"listing","id":"1"}}]}},"aggs":{"c":{"children":{"type":"listing"}},"p":{"parent":{"type":"listing"}}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	should := parsed["query"].(es.Object)["bool"].(es.BoolType)["should"].(es.ShouldType)
+	assert.IsTypeString(t, "es.hasChildType", should[0])
+	assert.IsTypeString(t, "es.hasParentType", should[1])
+	assert.IsTypeString(t, "es.parentIDType", should[2])
+	assert.IsTypeString(t, "es.childrenAggType", parsed["aggs"].(es.Object)["c"])
+	assert.IsTypeString(t, "es.parentAggType", parsed["aggs"].(es.Object)["p"])
+}
+
+func Test_ParseQuery_should_reconstruct_span_queries(t *testing.T) {
+	t.Parallel()
+	// Given
+	// nolint:golint,lll
+	body := `{"query":{"span_near":{"clauses":[{"span_term":{"body":"breach"}},{"span_multi":{"match":{"prefix":{"body":"contr"}}}},{"span_not":{"include":{"span_term":{"body":"a"}},"exclude":{"span_term":{"body":"b"}}}}],"slop":3}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+	_, notSpanErr := es.ParseQuery([]byte(`{"query":{"span_or":{"clauses":[{"term":{"body":"a"}}]}}}`))
+	_, notMultiTermErr := es.ParseQuery([]byte(`{"query":{"span_multi":{"match":{"term":{"body":"a"}}}}}`))
+
+	// Then
+	assert.Nil(t, err)
+	assert.IsTypeString(t, "es.spanNearType", parsed["query"])
+	bodyJSON := assert.MarshalWithoutError(t, parsed)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"span_near\":{\"clauses\":[{\"span_term\":{\"body\":{\"value\":\"breach\"}}},{\"span_multi\":{\"match\":{\"prefix\":{\"body\":{\"value\":\"contr\"}}}}},{\"span_not\":{\"exclude\":{\"span_term\":{\"body\":{\"value\":\"b\"}}},\"include\":{\"span_term\":{\"body\":{\"value\":\"a\"}}}}}],\"slop\":3}}}", bodyJSON)
+	assert.Equal(t, "query.span_or.clauses[0]: expected a span query", notSpanErr.Error())
+	assert.Equal(t, "query.span_multi.match: expected a prefix, wildcard, regexp, fuzzy or range query", notMultiTermErr.Error())
+}
+
+func Test_ParseQuery_should_accept_empty_query_clause(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := assert.MarshalWithoutError(t, es.NewQuery(nil))
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	assert.IsTypeString(t, "es.Object", parsed["query"])
+	assert.Equal(t, body, assert.MarshalWithoutError(t, parsed))
+}
+
+func Test_ParseQuery_should_normalize_aggregations_key_to_aggs(t *testing.T) {
+	t.Parallel()
+	// Given
+	// nolint:golint,lll
+	body := `{"aggregations":{"by_brand":{"terms":{"field":"brand"},"aggregations":{"avg_price":{"avg":{"field":"price"}}}}},"query":{"match_all":{}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	_, hasAggregations := parsed["aggregations"]
+	assert.False(t, hasAggregations)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"aggs\":{\"by_brand\":{\"aggs\":{\"avg_price\":{\"avg\":{\"field\":\"price\"}}},\"terms\":{\"field\":\"brand\"}}},\"query\":{\"match_all\":{}}}", assert.MarshalWithoutError(t, parsed))
+	parsed.Aggs(es.Agg("max_price", es.MaxAgg("price")))
+	// nolint:golint,lll
+	assert.Equal(t, "{\"aggs\":{\"max_price\":{\"max\":{\"field\":\"price\"}}},\"query\":{\"match_all\":{}}}", assert.MarshalWithoutError(t, parsed))
+}
+
+func Test_ParseQuery_should_normalize_short_forms(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"bool":{"filter":{"term":{"brand":"apple"}},"must":[{"match":{"title":"phone"}}]}},"sort":[{"date":"desc"},"_score"]}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	bodyJSON := assert.MarshalWithoutError(t, parsed)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"bool\":{\"filter\":[{\"term\":{\"brand\":{\"value\":\"apple\"}}}],\"must\":[{\"match\":{\"title\":{\"query\":\"phone\"}}}]}},\"sort\":[{\"date\":{\"order\":\"desc\"}},{\"_score\":{}}]}", bodyJSON)
+}
+
+func Test_ParseQuery_should_preserve_number_precision(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"term":{"id":{"value":9007199254740993}}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, body, assert.MarshalWithoutError(t, parsed))
+}
+
+func Test_ParseQuery_should_keep_unknown_root_keys(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"min_score":0.5,"query":{"match_all":{}},"track_total_hits":true}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, body, assert.MarshalWithoutError(t, parsed))
+}
+
+func Test_ParseQuery_should_parse_scripts_into_scriptType(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.ScriptQuery(es.ScriptSource("doc['a'].value > 1", ScriptLanguage.Painless))).
+		Aggs(es.Agg("total", es.SumAgg("price").Script(es.ScriptSource("_value * 2", ScriptLanguage.Painless))))
+	expected := assert.MarshalWithoutError(t, query)
+
+	// When
+	parsed, err := es.ParseQuery([]byte(expected))
+
+	// Then
+	assert.Nil(t, err)
+	assert.IsTypeString(t, "es.scriptQueryType", parsed["query"])
+	assert.Equal(t, expected, assert.MarshalWithoutError(t, parsed))
+}
+
+func Test_ParseQuery_should_return_error_for_unknown_query_clause(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"bool":{"filter":[{"term":{"a":"b"}},{"exists":{"field":"c"}},{"percolate":{}}]}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.True(t, parsed == nil)
+	assert.True(t, errors.Is(err, es.ErrUnknownClause))
+	assert.Equal(t, "query.bool.filter[2]: unknown clause \"percolate\"", err.Error())
+}
+
+func Test_ParseQuery_should_return_error_for_unknown_aggregation(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"aggs":{"outer":{"terms":{"field":"a"},"aggs":{"inner":{"geohash_grid":{"field":"loc"}}}}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.True(t, parsed == nil)
+	assert.True(t, errors.Is(err, es.ErrUnknownClause))
+	assert.Equal(t, "aggs.outer.aggs.inner: unknown clause \"geohash_grid\"", err.Error())
+}
+
+func Test_ParseQuery_should_return_error_when_clause_has_multiple_keys(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"term":{"a":"b"},"exists":{"field":"c"}}}`
+
+	// When
+	_, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Equal(t, "query: expected a single query clause, got 2 keys", err.Error())
+}
+
+func Test_ParseQuery_should_return_error_when_aggregation_has_no_type(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"aggs":{"empty":{"meta":{"a":1}}}}`
+
+	// When
+	_, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Equal(t, "aggs.empty: missing aggregation type", err.Error())
+}
+
+func Test_ParseQuery_should_return_error_when_body_is_invalid(t *testing.T) {
+	t.Parallel()
+	// Given When
+	_, invalidErr := es.ParseQuery([]byte(`{"query":`))
+	_, arrayErr := es.ParseQuery([]byte(`[]`))
+
+	// Then
+	assert.NotNil(t, invalidErr)
+	assert.Equal(t, "invalid search body: expected a JSON object", arrayErr.Error())
+}
+
+func Test_ParseQuery_result_should_marshal_like_the_input(t *testing.T) {
+	t.Parallel()
+	// Given
+	// nolint:golint,lll
+	body := `{"aggs":{"kinds":{"filters":{"filters":[{"term":{"a":{"value":"b"}}}]}}},"query":{"nested":{"path":"p","query":{"constant_score":{"filter":{"geo_distance":{"distance":"1km","loc":{"lat":1,"lon":2}}}}}}}}`
+
+	// When
+	parsed, err := es.ParseQuery([]byte(body))
+
+	// Then
+	assert.Nil(t, err)
+	var expected, actual any
+	assert.Nil(t, json.Unmarshal([]byte(body), &expected))
+	assert.Nil(t, json.Unmarshal([]byte(assert.MarshalWithoutError(t, parsed)), &actual))
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,305 @@
+// Package pit pages through every hit of a search with a point in time (PIT)
+// and search_after, which is the recommended way to read deep result sets.
+package pit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/es/transport"
+)
+
+// DefaultKeepAlive is how long the point in time is kept alive between pages
+// unless Pager.KeepAlive sets another value.
+const DefaultKeepAlive = "1m"
+
+// tiebreaker is the sort field appended to the base sort so that every hit has
+// unique sort values and no hit is skipped or repeated between pages.
+const tiebreaker = "_shard_doc"
+
+// Pager reads the hits of a search page by page. Each request body is the base
+// body with "pit", a _shard_doc tiebreaker sort and the "search_after" values
+// of the last hit of the previous page.
+//
+// A Pager can be driven by its transport.Func with Next, or by any client with
+// Body and Advance.
+type Pager[T any] struct {
+	do          transport.Func
+	base        es.Object
+	index       string
+	keepAlive   string
+	pitID       string
+	searchAfter []any
+	done        bool
+}
+
+// NewPager creates a pager over the hits of base in the given indices.
+//
+// Example usage:
+//
+//	pager := pit.NewPager[Product](transport.HTTP(http.DefaultClient, url), "products",
+//		es.NewQuery(es.Term("brand", "apple")).Size(500).Sort(es.Sort("date").Order(Order.Desc)))
+//	defer pager.Close(ctx)
+//	for {
+//		page, err := pager.Next(ctx)
+//		if errors.Is(err, io.EOF) {
+//			break
+//		}
+//		if err != nil {
+//			return err
+//		}
+//		// use page.Hits.Hits
+//	}
+//
+// Parameters:
+//   - do: The transport.Func used to open the point in time, search and close it.
+//   - index: The indices the point in time is opened on, separated by commas.
+//   - base: The search body. Its "size" sets the page size.
+//
+// Returns:
+//
+//	A pointer to a pit.Pager that opens the point in time on the first call to Next.
+func NewPager[T any](do transport.Func, index string, base es.Object) *Pager[T] {
+	return &Pager[T]{
+		do:        do,
+		base:      base,
+		index:     index,
+		keepAlive: DefaultKeepAlive,
+	}
+}
+
+// KeepAlive sets how long the point in time is kept alive between pages.
+//
+// Parameters:
+//   - keepAlive: A time value such as "5m".
+//
+// Returns:
+//
+//	The pit.Pager, for chaining.
+func (p *Pager[T]) KeepAlive(keepAlive string) *Pager[T] {
+	p.keepAlive = keepAlive
+	return p
+}
+
+// WithPitID makes the pager use a point in time that is already open instead
+// of opening one on the first call to Next.
+//
+// Parameters:
+//   - pitID: The id of the open point in time.
+//
+// Returns:
+//
+//	The pit.Pager, for chaining.
+func (p *Pager[T]) WithPitID(pitID string) *Pager[T] {
+	p.pitID = pitID
+	return p
+}
+
+// PitID returns the id of the point in time, which is updated from every page.
+func (p *Pager[T]) PitID() string {
+	return p.pitID
+}
+
+// Body returns the request body of the next page. It is a copy of the base body
+// with "pit", the _shard_doc tiebreaker and "search_after" set, so the base body
+// is never modified.
+//
+// Returns:
+//
+//	The es.Object to send to the _search endpoint, without an index in the path.
+func (p *Pager[T]) Body() es.Object {
+	body := make(es.Object, len(p.base)+2)
+	for key, value := range p.base {
+		body[key] = value
+	}
+	body["pit"] = es.Object{
+		"id":         p.pitID,
+		"keep_alive": p.keepAlive,
+	}
+	if !hasSort(body["sort"], tiebreaker) {
+		body["sort"] = appendSort(body["sort"], es.Sort(tiebreaker))
+	}
+	return body.SearchAfter(p.searchAfter...)
+}
+
+// Advance records a decoded page and returns the body of the page after it.
+//
+// Parameters:
+//   - page: The decoded response of the last body returned by Body or Advance.
+//
+// Returns:
+//
+//	The es.Object of the next page, and false when the page was the last one:
+//	it has no hits or fewer hits than the "size" of the base body.
+func (p *Pager[T]) Advance(page *response.SearchResponse[T]) (es.Object, bool) {
+	if page.PitID != "" {
+		p.pitID = page.PitID
+	}
+	hits := page.Hits.Hits
+	if len(hits) == 0 {
+		p.done = true
+		return nil, false
+	}
+	p.searchAfter = hits[len(hits)-1].Sort
+	if size, ok := asNumber(p.base["size"]); ok && float64(len(hits)) < size {
+		p.done = true
+		return nil, false
+	}
+	return p.Body(), true
+}
+
+// Next opens the point in time when needed, fetches the next page and advances
+// the pager past it.
+//
+// Parameters:
+//   - ctx: The context of the requests.
+//
+// Returns:
+//
+//	The next page with at least one hit, io.EOF when every hit has been read,
+//	or the error of the transport.
+func (p *Pager[T]) Next(ctx context.Context) (*response.SearchResponse[T], error) {
+	if p.done {
+		return nil, io.EOF
+	}
+	if p.pitID == "" {
+		if err := p.open(ctx); err != nil {
+			return nil, err
+		}
+	}
+	body, err := json.Marshal(p.Body())
+	if err != nil {
+		return nil, err
+	}
+	data, err := p.do(ctx, http.MethodPost, "/_search", body)
+	if err != nil {
+		return nil, err
+	}
+	page, err := response.Unmarshal[T](data)
+	if err != nil {
+		return nil, err
+	}
+	p.Advance(page)
+	if len(page.Hits.Hits) == 0 {
+		return nil, io.EOF
+	}
+	return page, nil
+}
+
+// Close closes the point in time so the cluster can free its resources. It
+// does nothing when no point in time is open.
+//
+// Parameters:
+//   - ctx: The context of the request.
+//
+// Returns:
+//
+//	The error of the transport, if any.
+func (p *Pager[T]) Close(ctx context.Context) error {
+	if p.pitID == "" {
+		return nil
+	}
+	body, err := json.Marshal(es.Object{"id": p.pitID})
+	if err != nil {
+		return err
+	}
+	if _, err = p.do(ctx, http.MethodDelete, "/_pit", body); err != nil {
+		return err
+	}
+	p.pitID = ""
+	p.done = true
+	return nil
+}
+
+func (p *Pager[T]) open(ctx context.Context) error {
+	path := "/" + escapeIndex(p.index) + "/_pit?keep_alive=" + url.QueryEscape(p.keepAlive)
+	data, err := p.do(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return err
+	}
+	var opened struct {
+		ID string `json:"id"`
+	}
+	if err = json.Unmarshal(data, &opened); err != nil {
+		return err
+	}
+	p.pitID = opened.ID
+	return nil
+}
+
+// appendSort returns a new es.Array with the entries of sort, which can be a
+// slice built with es.Sort, a parsed es.Array or a single parsed entry,
+// followed by entry. The slice of the base body is never appended to.
+func appendSort(sort, entry any) es.Array {
+	sorts := reflect.ValueOf(sort)
+	if sorts.Kind() != reflect.Slice {
+		if sort == nil {
+			return es.Array{entry}
+		}
+		return es.Array{sort, entry}
+	}
+	array := make(es.Array, 0, sorts.Len()+1)
+	for i := 0; i < sorts.Len(); i++ {
+		array = append(array, sorts.Index(i).Interface())
+	}
+	return append(array, entry)
+}
+
+// hasSort reports whether the "sort" of a body, a slice of es.Sort results,
+// already sorts on field.
+func hasSort(sort any, field string) bool {
+	sorts := reflect.ValueOf(sort)
+	if sorts.Kind() != reflect.Slice {
+		return false
+	}
+	for i := 0; i < sorts.Len(); i++ {
+		entry := reflect.Indirect(sorts.Index(i))
+		if entry.Kind() == reflect.Interface {
+			entry = entry.Elem()
+		}
+		if entry.Kind() == reflect.Map && entry.MapIndex(reflect.ValueOf(field)).IsValid() {
+			return true
+		}
+	}
+	return false
+}
+
+// asNumber converts the numeric "size" of a body into a float64, whether it was
+// set from Go code or decoded from JSON as a json.Number or float64.
+func asNumber(value any) (float64, bool) {
+	if number, ok := value.(json.Number); ok {
+		f, err := number.Float64()
+		return f, err == nil
+	}
+	if value == nil {
+		return 0, false
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// escapeIndex escapes each name of a comma-separated index list for a URL path,
+// keeping the commas that separate them.
+func escapeIndex(index string) string {
+	names := strings.Split(index, ",")
+	for i := 0; i < len(names); i++ {
+		names[i] = url.PathEscape(names[i])
+	}
+	return strings.Join(names, ",")
+}
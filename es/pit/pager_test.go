@@ -0,0 +1,274 @@
+package pit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	Order "github.com/Trendyol/es-query-builder/es/enums/sort/order"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/es/pit"
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/es/transport"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+type product struct {
+	Name string `json:"name"`
+}
+
+// cluster is a local stand-in for the point in time and search endpoints. It
+// serves the names in order, sorted by their position.
+type cluster struct {
+	names    []string
+	requests []string
+	bodies   []string
+	mu       sync.Mutex
+	closed   bool
+}
+
+func (c *cluster) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, _ := io.ReadAll(r.Body)
+	c.requests = append(c.requests, r.Method+" "+r.URL.RequestURI())
+	switch {
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/_pit"):
+		_, _ = w.Write([]byte(`{"id":"pit-1"}`))
+	case r.Method == http.MethodDelete && r.URL.Path == "/_pit":
+		c.closed = true
+		_, _ = w.Write([]byte(`{"succeeded":true,"num_freed":1}`))
+	case r.URL.Path == "/_search":
+		c.bodies = append(c.bodies, string(data))
+		c.search(w, data)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (c *cluster) search(w http.ResponseWriter, data []byte) {
+	var body struct {
+		Size        *int  `json:"size"`
+		SearchAfter []int `json:"search_after"`
+	}
+	_ = json.Unmarshal(data, &body)
+	from, size := 0, 10
+	if len(body.SearchAfter) > 0 {
+		from = body.SearchAfter[0] + 1
+	}
+	if body.Size != nil {
+		size = *body.Size
+	}
+	hits := make([]string, 0, size)
+	for i := from; i < len(c.names) && len(hits) < size; i++ {
+		hits = append(hits, fmt.Sprintf(`{"_id":"%d","_source":{"name":%q},"sort":[%d]}`, i, c.names[i], i))
+	}
+	_, _ = fmt.Fprintf(w, `{"pit_id":"pit-%d","hits":{"hits":[%s]}}`, from+2, strings.Join(hits, ","))
+}
+
+func readAll(t *testing.T, pager *pit.Pager[product]) []string {
+	t.Helper()
+	var names []string
+	for {
+		page, err := pager.Next(context.Background())
+		if errors.Is(err, io.EOF) {
+			return names
+		}
+		assert.Nil(t, err)
+		for _, hit := range page.Hits.Hits {
+			names = append(names, hit.Source.Name)
+		}
+	}
+}
+
+////   Pager   ////
+
+func Test_Pager_Body_should_inject_pit_and_tiebreaker(t *testing.T) {
+	t.Parallel()
+	// Given
+	base := es.NewQuery(es.Term("brand", "apple")).Size(2).Sort(es.Sort("date").Order(Order.Desc))
+	pager := pit.NewPager[product](nil, "products", base).WithPitID("abc").KeepAlive("5m")
+
+	// When
+	body := pager.Body()
+
+	// Then
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	baseJSON := assert.MarshalWithoutError(t, base)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"pit\":{\"id\":\"abc\",\"keep_alive\":\"5m\"},\"query\":{\"term\":{\"brand\":{\"value\":\"apple\"}}},\"size\":2,\"sort\":[{\"date\":{\"order\":\"desc\"}},{\"_shard_doc\":{}}]}", bodyJSON)
+	assert.Equal(t, "{\"query\":{\"term\":{\"brand\":{\"value\":\"apple\"}}},\"size\":2,\"sort\":[{\"date\":{\"order\":\"desc\"}}]}", baseJSON)
+	assert.Equal(t, "abc", pager.PitID())
+}
+
+func Test_Pager_Body_should_keep_existing_tiebreaker(t *testing.T) {
+	t.Parallel()
+	// Given
+	base := es.NewQuery(nil).Sort(es.Sort("_shard_doc").Order(Order.Asc))
+	pager := pit.NewPager[product](nil, "products", base).WithPitID("abc")
+
+	// When
+	body := pager.Body()
+
+	// Then
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	assert.Equal(t, "{\"pit\":{\"id\":\"abc\",\"keep_alive\":\"1m\"},\"query\":{},\"sort\":[{\"_shard_doc\":{\"order\":\"asc\"}}]}", bodyJSON)
+}
+
+func Test_Pager_Body_should_append_tiebreaker_to_parsed_sort(t *testing.T) {
+	t.Parallel()
+	// Given
+	parsed, err := es.ParseQuery([]byte(`{"query":{"match_all":{}},"sort":[{"date":{"order":"desc"}},"_score"]}`))
+	assert.Nil(t, err)
+	decoded := es.Object{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"sort":[{"date":"asc"}]}`), &decoded))
+
+	// When
+	parsedBody := pit.NewPager[product](nil, "products", parsed).WithPitID("abc").Body()
+	decodedBody := pit.NewPager[product](nil, "products", decoded).WithPitID("abc").Body()
+
+	// Then
+	// nolint:golint,lll
+	assert.Equal(t, "{\"pit\":{\"id\":\"abc\",\"keep_alive\":\"1m\"},\"query\":{\"match_all\":{}},\"sort\":[{\"date\":{\"order\":\"desc\"}},{\"_score\":{}},{\"_shard_doc\":{}}]}", assert.MarshalWithoutError(t, parsedBody))
+	assert.Equal(t, "{\"pit\":{\"id\":\"abc\",\"keep_alive\":\"1m\"},\"sort\":[{\"date\":\"asc\"},{\"_shard_doc\":{}}]}", assert.MarshalWithoutError(t, decodedBody))
+	assert.Equal(t, "{\"sort\":[{\"date\":\"asc\"}]}", assert.MarshalWithoutError(t, decoded))
+}
+
+func Test_Pager_Advance_should_yield_next_body_until_last_page(t *testing.T) {
+	t.Parallel()
+	// Given
+	pager := pit.NewPager[product](nil, "products", es.NewQuery(nil).Size(2)).WithPitID("abc")
+	full := &response.SearchResponse[product]{PitID: "def"}
+	full.Hits.Hits = []response.Hit[product]{{Sort: []any{"a", 1}}, {Sort: []any{"b", 2}}}
+	partial := &response.SearchResponse[product]{}
+	partial.Hits.Hits = []response.Hit[product]{{Sort: []any{"c", 3}}}
+
+	// When
+	next, hasNext := pager.Advance(full)
+	last, hasLast := pager.Advance(partial)
+
+	// Then
+	assert.True(t, hasNext)
+	assert.False(t, hasLast)
+	assert.True(t, last == nil)
+	bodyJSON := assert.MarshalWithoutError(t, next)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"pit\":{\"id\":\"def\",\"keep_alive\":\"1m\"},\"query\":{},\"search_after\":[\"b\",2],\"size\":2,\"sort\":[{\"_shard_doc\":{}}]}", bodyJSON)
+}
+
+func Test_Pager_Advance_should_stop_on_partial_page_with_decoded_size(t *testing.T) {
+	t.Parallel()
+	// Given
+	parsed, err := es.ParseQuery([]byte(`{"size":2}`))
+	assert.Nil(t, err)
+	decoded := es.Object{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"size":2}`), &decoded))
+	partial := &response.SearchResponse[product]{}
+	partial.Hits.Hits = []response.Hit[product]{{Sort: []any{"c", 3}}}
+
+	// When
+	_, parsedHasNext := pit.NewPager[product](nil, "products", parsed).WithPitID("abc").Advance(partial)
+	_, decodedHasNext := pit.NewPager[product](nil, "products", decoded).WithPitID("abc").Advance(partial)
+
+	// Then
+	assert.False(t, parsedHasNext)
+	assert.False(t, decodedHasNext)
+}
+
+func Test_Pager_Next_should_read_every_page_through_transport(t *testing.T) {
+	t.Parallel()
+	// Given
+	stub := &cluster{names: []string{"a", "b", "c", "d", "e"}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	pager := pit.NewPager[product](transport.HTTP(server.Client(), server.URL), "products", es.NewQuery(es.MatchAll()).Size(2))
+
+	// When
+	names := readAll(t, pager)
+	closeErr := pager.Close(context.Background())
+
+	// Then
+	assert.Nil(t, closeErr)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+	assert.Equal(t, []string{
+		"POST /products/_pit?keep_alive=1m",
+		"POST /_search",
+		"POST /_search",
+		"POST /_search",
+		"DELETE /_pit",
+	}, stub.requests)
+	assert.True(t, strings.Contains(stub.bodies[2], `"pit":{"id":"pit-4","keep_alive":"1m"}`))
+	assert.True(t, strings.Contains(stub.bodies[2], `"search_after":[3]`))
+	assert.True(t, stub.closed)
+	assert.Equal(t, "", pager.PitID())
+}
+
+func Test_Pager_Next_should_stop_on_empty_page_without_size(t *testing.T) {
+	t.Parallel()
+	// Given
+	stub := &cluster{names: []string{"a", "b", "c"}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	pager := pit.NewPager[product](transport.HTTP(server.Client(), server.URL), "products", es.NewQuery(nil))
+
+	// When
+	names := readAll(t, pager)
+	_, again := pager.Next(context.Background())
+
+	// Then
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+	assert.Equal(t, 3, len(stub.requests))
+	assert.True(t, errors.Is(again, io.EOF))
+}
+
+func Test_Pager_should_keep_commas_of_index_list_in_path(t *testing.T) {
+	t.Parallel()
+	// Given
+	var requests []string
+	failing := func(_ context.Context, method, path string, _ []byte) ([]byte, error) {
+		requests = append(requests, method+" "+path)
+		return nil, errors.New("unavailable")
+	}
+
+	// When
+	_, err := pit.NewPager[product](failing, "products,archive/2024", es.NewQuery(nil)).Next(context.Background())
+
+	// Then
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"POST /products,archive%2F2024/_pit?keep_alive=1m"}, requests)
+}
+
+func Test_Pager_should_return_transport_errors(t *testing.T) {
+	t.Parallel()
+	// Given
+	failing := func(_ context.Context, method, path string, _ []byte) ([]byte, error) {
+		return nil, fmt.Errorf("%s %s failed", method, path)
+	}
+	invalid := func(_ context.Context, _, _ string, _ []byte) ([]byte, error) {
+		return []byte(`{"id":`), nil
+	}
+
+	// When
+	_, openErr := pit.NewPager[product](failing, "products", es.NewQuery(nil)).Next(context.Background())
+	_, searchErr := pit.NewPager[product](failing, "products", es.NewQuery(nil)).WithPitID("abc").Next(context.Background())
+	_, decodeErr := pit.NewPager[product](invalid, "products", es.NewQuery(nil)).Next(context.Background())
+	_, pageErr := pit.NewPager[product](invalid, "products", es.NewQuery(nil)).WithPitID("abc").Next(context.Background())
+	closeErr := pit.NewPager[product](failing, "products", es.NewQuery(nil)).WithPitID("abc").Close(context.Background())
+	noopErr := pit.NewPager[product](failing, "products", es.NewQuery(nil)).Close(context.Background())
+
+	// Then
+	assert.Equal(t, "POST /products/_pit?keep_alive=1m failed", openErr.Error())
+	assert.Equal(t, "POST /_search failed", searchErr.Error())
+	assert.NotNil(t, decodeErr)
+	assert.NotNil(t, pageErr)
+	assert.Equal(t, "DELETE /_pit failed", closeErr.Error())
+	assert.Nil(t, noopErr)
+}
@@ -0,0 +1,59 @@
+package es
+
+type pruningConfigType Object
+
+// PruningConfig creates an empty es.pruningConfigType object, which controls how
+// sparse vector queries drop tokens that are frequent or have low weights.
+//
+// Example usage:
+//
+//	p := es.PruningConfig().TokensFreqRatioThreshold(5).TokensWeightThreshold(0.4)
+//	// p now contains {"tokens_freq_ratio_threshold": 5, "tokens_weight_threshold": 0.4}
+//
+// Returns:
+//
+//	An empty es.pruningConfigType object.
+func PruningConfig() pruningConfigType {
+	return pruningConfigType{}
+}
+
+// TokensFreqRatioThreshold sets how many times more frequent than the average a
+// token has to be to be pruned.
+//
+// Parameters:
+//   - threshold: The frequency ratio, between 1 and 100.
+//
+// Returns:
+//
+//	The updated es.pruningConfigType object with the "tokens_freq_ratio_threshold" field set.
+func (pc pruningConfigType) TokensFreqRatioThreshold(threshold float64) pruningConfigType {
+	pc["tokens_freq_ratio_threshold"] = threshold
+	return pc
+}
+
+// TokensWeightThreshold sets the weight below which tokens are pruned.
+//
+// Parameters:
+//   - threshold: The weight threshold, between 0 and 1.
+//
+// Returns:
+//
+//	The updated es.pruningConfigType object with the "tokens_weight_threshold" field set.
+func (pc pruningConfigType) TokensWeightThreshold(threshold float64) pruningConfigType {
+	pc["tokens_weight_threshold"] = threshold
+	return pc
+}
+
+// OnlyScorePrunedTokens makes the query score documents with the pruned tokens
+// only, instead of the tokens that are kept.
+//
+// Parameters:
+//   - onlyScorePrunedTokens: A boolean enabling or disabling scoring with the pruned tokens.
+//
+// Returns:
+//
+//	The updated es.pruningConfigType object with the "only_score_pruned_tokens" field set.
+func (pc pruningConfigType) OnlyScorePrunedTokens(onlyScorePrunedTokens bool) pruningConfigType {
+	pc["only_score_pruned_tokens"] = onlyScorePrunedTokens
+	return pc
+}
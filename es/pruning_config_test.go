@@ -0,0 +1,31 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   PruningConfig   ////
+
+func Test_PruningConfig_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.PruningConfig)
+}
+
+func Test_PruningConfig_should_create_json_with_all_thresholds(t *testing.T) {
+	t.Parallel()
+	// Given
+	pruningConfig := es.PruningConfig().
+		TokensFreqRatioThreshold(5).
+		TokensWeightThreshold(0.4).
+		OnlyScorePrunedTokens(false)
+
+	// When Then
+	assert.IsTypeString(t, "es.pruningConfigType", pruningConfig)
+	bodyJSON := assert.MarshalWithoutError(t, pruningConfig)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"only_score_pruned_tokens\":false,\"tokens_freq_ratio_threshold\":5,\"tokens_weight_threshold\":0.4}", bodyJSON)
+}
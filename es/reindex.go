@@ -0,0 +1,342 @@
+package es
+
+import (
+	Conflicts "github.com/Trendyol/es-query-builder/es/enums/conflicts"
+	OpType "github.com/Trendyol/es-query-builder/es/enums/op-type"
+	VersionType "github.com/Trendyol/es-query-builder/es/enums/version-type"
+)
+
+type reindexType Object
+
+type reindexSourceType Object
+
+type reindexRemoteType Object
+
+type reindexDestType Object
+
+// Reindex creates the body of a _reindex request, which copies the documents of
+// the source into the destination index.
+//
+// Example usage:
+//
+//	body := es.Reindex(
+//		es.ReindexSource("products").Query(es.Term("status", "active")).SourceIncludes("id", "title"),
+//		es.ReindexDest("products-v2").OpType(OpType.Create),
+//	).Script(es.ScriptSource("ctx._source.title = ctx._source.title.trim()", ScriptLanguage.Painless))
+//	// body now contains the "source", "dest" and "script" fields.
+//
+// Parameters:
+//   - source: An es.reindexSourceType created with es.ReindexSource.
+//   - dest: An es.reindexDestType created with es.ReindexDest.
+//
+// Returns:
+//
+//	An es.reindexType object with the "source" and "dest" fields set.
+func Reindex(source reindexSourceType, dest reindexDestType) reindexType {
+	return reindexType{
+		"source": source,
+		"dest":   dest,
+	}
+}
+
+// Script sets the script that transforms each document before it is written to
+// the destination.
+//
+// Parameters:
+//   - script: An es.scriptType created with es.ScriptSource or es.ScriptID.
+//
+// Returns:
+//
+//	The updated es.reindexType object with the "script" field set.
+func (r reindexType) Script(script scriptType) reindexType {
+	r["script"] = script
+	return r
+}
+
+// MaxDocs sets the maximum number of documents the request reindexes.
+//
+// Parameters:
+//   - maxDocs: The maximum number of documents to reindex.
+//
+// Returns:
+//
+//	The updated es.reindexType object with the "max_docs" field set.
+func (r reindexType) MaxDocs(maxDocs int) reindexType {
+	r["max_docs"] = maxDocs
+	return r
+}
+
+// Conflicts sets whether the request aborts or proceeds on version conflicts.
+//
+// Parameters:
+//   - conflicts: A Conflicts.Conflicts value.
+//
+// Returns:
+//
+//	The updated es.reindexType object with the "conflicts" field set.
+func (r reindexType) Conflicts(conflicts Conflicts.Conflicts) reindexType {
+	r["conflicts"] = conflicts
+	return r
+}
+
+// ReindexSource creates the "source" of a reindex request.
+//
+// Example usage:
+//
+//	s := es.ReindexSource("products", "products-archive").Size(500)
+//	// s now contains {"index": ["products", "products-archive"], "size": 500}
+//
+// Parameters:
+//   - indices: One or more index names or patterns to copy documents from.
+//
+// Returns:
+//
+//	An es.reindexSourceType object with the "index" field set.
+func ReindexSource(indices ...string) reindexSourceType {
+	s := reindexSourceType{}
+	if len(indices) == 1 {
+		s["index"] = indices[0]
+	} else {
+		s["index"] = indices
+	}
+	return s
+}
+
+// Query sets the query selecting the documents to reindex. A search body created
+// with es.NewQuery is accepted as well, in which case only its "query" is used.
+//
+// Example usage:
+//
+//	s := es.ReindexSource("products").Query(es.Bool().Filter(es.Term("status", "active")))
+//	// s now contains {"index": "products", "query": {"bool": {"filter": [...]}}}
+//
+// Parameters:
+//   - queryClause: The query clause, or an es.Object created with es.NewQuery.
+//
+// Returns:
+//
+//	The updated es.reindexSourceType object with the "query" field set.
+func (s reindexSourceType) Query(queryClause any) reindexSourceType {
+	if object, ok := queryClause.(Object); ok {
+		if query, exists := object["query"]; exists {
+			queryClause = query
+		}
+	}
+	if field, ok := correctType(queryClause); ok {
+		s["query"] = field
+	}
+	return s
+}
+
+// SourceIncludes limits the copied documents to the given fields.
+//
+// Parameters:
+//   - fields: The fields to keep in the _source of the copied documents.
+//
+// Returns:
+//
+//	The updated es.reindexSourceType object with "_source.includes" set.
+func (s reindexSourceType) SourceIncludes(fields ...string) reindexSourceType {
+	return reindexSourceType(Object(s).SourceIncludes(fields...))
+}
+
+// SourceExcludes removes the given fields from the copied documents.
+//
+// Parameters:
+//   - fields: The fields to drop from the _source of the copied documents.
+//
+// Returns:
+//
+//	The updated es.reindexSourceType object with "_source.excludes" set.
+func (s reindexSourceType) SourceExcludes(fields ...string) reindexSourceType {
+	return reindexSourceType(Object(s).SourceExcludes(fields...))
+}
+
+// Sort sets the order the source documents are read in, which decides which
+// documents are copied when it is combined with max_docs.
+//
+// Parameters:
+//   - sorts: The es.sortType values created with es.Sort.
+//
+// Returns:
+//
+//	The updated es.reindexSourceType object with the "sort" field set.
+func (s reindexSourceType) Sort(sorts ...sortType) reindexSourceType {
+	return reindexSourceType(Object(s).Sort(sorts...))
+}
+
+// Size sets the number of documents read from the source in each batch.
+//
+// Parameters:
+//   - size: The batch size.
+//
+// Returns:
+//
+//	The updated es.reindexSourceType object with the "size" field set.
+func (s reindexSourceType) Size(size int) reindexSourceType {
+	s["size"] = size
+	return s
+}
+
+// Remote makes the request read the source indices from a remote cluster.
+//
+// Example usage:
+//
+//	s := es.ReindexSource("products").Remote(es.ReindexRemote("https://old-cluster:9200").Username("reindex").Password(pass))
+//
+// Parameters:
+//   - remote: An es.reindexRemoteType created with es.ReindexRemote.
+//
+// Returns:
+//
+//	The updated es.reindexSourceType object with the "remote" field set.
+func (s reindexSourceType) Remote(remote reindexRemoteType) reindexSourceType {
+	s["remote"] = remote
+	return s
+}
+
+// ReindexRemote creates the "remote" cluster of a reindex source. The host must
+// be listed in the reindex.remote.whitelist setting of the destination cluster.
+//
+// Parameters:
+//   - host: The URL of the remote cluster, including scheme and port.
+//
+// Returns:
+//
+//	An es.reindexRemoteType object with the "host" field set.
+func ReindexRemote(host string) reindexRemoteType {
+	return reindexRemoteType{
+		"host": host,
+	}
+}
+
+// Username sets the user used to authenticate against the remote cluster.
+//
+// Parameters:
+//   - username: The user name.
+//
+// Returns:
+//
+//	The updated es.reindexRemoteType object with the "username" field set.
+func (r reindexRemoteType) Username(username string) reindexRemoteType {
+	r["username"] = username
+	return r
+}
+
+// Password sets the password used to authenticate against the remote cluster.
+//
+// Parameters:
+//   - password: The password.
+//
+// Returns:
+//
+//	The updated es.reindexRemoteType object with the "password" field set.
+func (r reindexRemoteType) Password(password string) reindexRemoteType {
+	r["password"] = password
+	return r
+}
+
+// Header adds an HTTP header sent with the requests to the remote cluster.
+//
+// Parameters:
+//   - name: The header name.
+//   - value: The header value.
+//
+// Returns:
+//
+//	The updated es.reindexRemoteType object with the header added to "headers".
+func (r reindexRemoteType) Header(name, value string) reindexRemoteType {
+	headers, ok := r["headers"].(GenericObject[string])
+	if !ok {
+		headers = GenericObject[string]{}
+		r["headers"] = headers
+	}
+	headers[name] = value
+	return r
+}
+
+// SocketTimeout sets the socket read timeout of the remote connection.
+//
+// Parameters:
+//   - timeout: A time value such as "1m".
+//
+// Returns:
+//
+//	The updated es.reindexRemoteType object with the "socket_timeout" field set.
+func (r reindexRemoteType) SocketTimeout(timeout string) reindexRemoteType {
+	r["socket_timeout"] = timeout
+	return r
+}
+
+// ConnectTimeout sets the connection timeout of the remote connection.
+//
+// Parameters:
+//   - timeout: A time value such as "10s".
+//
+// Returns:
+//
+//	The updated es.reindexRemoteType object with the "connect_timeout" field set.
+func (r reindexRemoteType) ConnectTimeout(timeout string) reindexRemoteType {
+	r["connect_timeout"] = timeout
+	return r
+}
+
+// ReindexDest creates the "dest" of a reindex request.
+//
+// Example usage:
+//
+//	d := es.ReindexDest("products-v2").OpType(OpType.Create).Pipeline("normalize")
+//	// d now contains {"index": "products-v2", "op_type": "create", "pipeline": "normalize"}
+//
+// Parameters:
+//   - index: The index, alias or data stream documents are written to.
+//
+// Returns:
+//
+//	An es.reindexDestType object with the "index" field set.
+func ReindexDest(index string) reindexDestType {
+	return reindexDestType{
+		"index": index,
+	}
+}
+
+// OpType sets whether existing documents of the destination are replaced or
+// reported as conflicts. Data streams require OpType.Create.
+//
+// Parameters:
+//   - opType: An OpType.OpType value.
+//
+// Returns:
+//
+//	The updated es.reindexDestType object with the "op_type" field set.
+func (d reindexDestType) OpType(opType OpType.OpType) reindexDestType {
+	d["op_type"] = opType
+	return d
+}
+
+// Pipeline sets the ingest pipeline the copied documents go through.
+//
+// Parameters:
+//   - pipeline: The pipeline id.
+//
+// Returns:
+//
+//	The updated es.reindexDestType object with the "pipeline" field set.
+func (d reindexDestType) Pipeline(pipeline string) reindexDestType {
+	d["pipeline"] = pipeline
+	return d
+}
+
+// VersionType sets how the versions of the source documents are applied to the
+// destination. VersionType.External keeps the newer of the two documents.
+//
+// Parameters:
+//   - versionType: A VersionType.VersionType value.
+//
+// Returns:
+//
+//	The updated es.reindexDestType object with the "version_type" field set.
+func (d reindexDestType) VersionType(versionType VersionType.VersionType) reindexDestType {
+	d["version_type"] = versionType
+	return d
+}
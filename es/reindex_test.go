@@ -0,0 +1,120 @@
+package es_test
+
+import (
+	"testing"
+
+	Conflicts "github.com/Trendyol/es-query-builder/es/enums/conflicts"
+	OpType "github.com/Trendyol/es-query-builder/es/enums/op-type"
+	ScriptLanguage "github.com/Trendyol/es-query-builder/es/enums/script-language"
+	Order "github.com/Trendyol/es-query-builder/es/enums/sort/order"
+	VersionType "github.com/Trendyol/es-query-builder/es/enums/version-type"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Reindex   ////
+
+func Test_Reindex_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.Reindex)
+	assert.NotNil(t, es.ReindexSource)
+	assert.NotNil(t, es.ReindexDest)
+	assert.NotNil(t, es.ReindexRemote)
+}
+
+func Test_Reindex_should_create_reindexType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	body := es.Reindex(es.ReindexSource("products"), es.ReindexDest("products-v2"))
+
+	// Then
+	assert.IsTypeString(t, "es.reindexType", body)
+	assert.IsTypeString(t, "es.reindexSourceType", es.ReindexSource("products"))
+	assert.IsTypeString(t, "es.reindexDestType", es.ReindexDest("products-v2"))
+	assert.IsTypeString(t, "es.reindexRemoteType", es.ReindexRemote("http://localhost:9200"))
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	assert.Equal(t, "{\"dest\":{\"index\":\"products-v2\"},\"source\":{\"index\":\"products\"}}", bodyJSON)
+}
+
+func Test_Reindex_should_create_json_with_script_max_docs_and_conflicts(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := es.Reindex(es.ReindexSource("products"), es.ReindexDest("products-v2")).
+		Script(es.ScriptSource("ctx._source.price *= params.rate", ScriptLanguage.Painless).Parameter("rate", 1.2)).
+		MaxDocs(100).
+		Conflicts(Conflicts.Proceed)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"conflicts\":\"proceed\",\"dest\":{\"index\":\"products-v2\"},\"max_docs\":100,\"script\":{\"lang\":\"painless\",\"params\":{\"rate\":1.2},\"source\":\"ctx._source.price *= params.rate\"},\"source\":{\"index\":\"products\"}}", bodyJSON)
+}
+
+func Test_ReindexSource_should_create_json_with_all_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	source := es.ReindexSource("products", "products-archive").
+		Query(es.Bool().Filter(es.Term("status", "active"))).
+		SourceIncludes("id", "title").
+		SourceExcludes("internal").
+		Sort(es.Sort("date").Order(Order.Desc)).
+		Size(500)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, source)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"_source\":{\"excludes\":[\"internal\"],\"includes\":[\"id\",\"title\"]},\"index\":[\"products\",\"products-archive\"],\"query\":{\"bool\":{\"filter\":[{\"term\":{\"status\":{\"value\":\"active\"}}}]}},\"size\":500,\"sort\":[{\"date\":{\"order\":\"desc\"}}]}", bodyJSON)
+}
+
+func Test_ReindexSource_Query_should_use_query_of_search_body(t *testing.T) {
+	t.Parallel()
+	// Given
+	source := es.ReindexSource("products").Query(es.NewQuery(es.Term("brand", "apple")).Size(10))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, source)
+	assert.Equal(t, "{\"index\":\"products\",\"query\":{\"term\":{\"brand\":{\"value\":\"apple\"}}}}", bodyJSON)
+}
+
+func Test_ReindexSource_Query_should_ignore_nil_query(t *testing.T) {
+	t.Parallel()
+	// Given
+	source := es.ReindexSource("products").Query(nil)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, source)
+	assert.Equal(t, "{\"index\":\"products\"}", bodyJSON)
+}
+
+func Test_ReindexSource_Remote_should_create_json_with_remote_field(t *testing.T) {
+	t.Parallel()
+	// Given
+	source := es.ReindexSource("products").Remote(
+		es.ReindexRemote("https://old-cluster:9200").
+			Username("reindex").
+			Password("secret").
+			Header("X-Tenant", "t-1").
+			SocketTimeout("1m").
+			ConnectTimeout("10s"),
+	)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, source)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"index\":\"products\",\"remote\":{\"connect_timeout\":\"10s\",\"headers\":{\"X-Tenant\":\"t-1\"},\"host\":\"https://old-cluster:9200\",\"password\":\"secret\",\"socket_timeout\":\"1m\",\"username\":\"reindex\"}}", bodyJSON)
+}
+
+func Test_ReindexDest_should_create_json_with_all_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	dest := es.ReindexDest("products-v2").
+		OpType(OpType.Create).
+		Pipeline("normalize").
+		VersionType(VersionType.External)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, dest)
+	assert.Equal(t, "{\"index\":\"products-v2\",\"op_type\":\"create\",\"pipeline\":\"normalize\",\"version_type\":\"external\"}", bodyJSON)
+}
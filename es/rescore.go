@@ -0,0 +1,155 @@
+package es
+
+import ScoreMode "github.com/Trendyol/es-query-builder/es/enums/score-mode"
+
+type rescoreType Object
+
+type rescoreQueryType Object
+
+type learningToRankType Object
+
+// Rescorer creates a rescorer, which recomputes the score of the top hits of
+// each shard with a more expensive scoring method.
+//
+// Example usage:
+//
+//	r := es.Rescorer(500).Query(
+//		es.RescoreQuery(es.MatchPhrase("title", "running shoes")).QueryWeight(0.7).RescoreQueryWeight(1.2),
+//	)
+//	// r now contains {"query": {...}, "window_size": 500}
+//
+// Parameters:
+//   - windowSize: The number of top hits of each shard that are rescored.
+//
+// Returns:
+//
+//	An es.rescoreType object with the "window_size" field set.
+func Rescorer(windowSize int) rescoreType {
+	return rescoreType{
+		"window_size": windowSize,
+	}
+}
+
+// Query makes the rescorer combine the original score with the score of a query.
+//
+// Parameters:
+//   - rescoreQuery: An es.rescoreQueryType created with es.RescoreQuery.
+//
+// Returns:
+//
+//	The updated es.rescoreType object with the "query" field set.
+func (r rescoreType) Query(rescoreQuery rescoreQueryType) rescoreType {
+	r["query"] = rescoreQuery
+	return r
+}
+
+// LearningToRank makes the rescorer score the hits with a trained model.
+//
+// Parameters:
+//   - learningToRank: An es.learningToRankType created with es.LearningToRank.
+//
+// Returns:
+//
+//	The updated es.rescoreType object with the "learning_to_rank" field set.
+func (r rescoreType) LearningToRank(learningToRank learningToRankType) rescoreType {
+	r["learning_to_rank"] = learningToRank
+	return r
+}
+
+// RescoreQuery creates the query rescorer of an es.Rescorer.
+//
+// Example usage:
+//
+//	q := es.RescoreQuery(es.MatchPhrase("title", "running shoes")).ScoreMode(ScoreMode.Multiply)
+//	// q now contains {"rescore_query": {"match_phrase": {...}}, "score_mode": "multiply"}
+//
+// Parameters:
+//   - queryClause: The query whose score is combined with the original score.
+//
+// Returns:
+//
+//	An es.rescoreQueryType object with the "rescore_query" field set.
+func RescoreQuery(queryClause any) rescoreQueryType {
+	r := rescoreQueryType{}
+	if field, ok := correctType(queryClause); ok {
+		r["rescore_query"] = field
+	}
+	return r
+}
+
+// QueryWeight sets the weight of the original score.
+//
+// Parameters:
+//   - queryWeight: The weight, 1 by default.
+//
+// Returns:
+//
+//	The updated es.rescoreQueryType object with the "query_weight" field set.
+func (r rescoreQueryType) QueryWeight(queryWeight float64) rescoreQueryType {
+	r["query_weight"] = queryWeight
+	return r
+}
+
+// RescoreQueryWeight sets the weight of the rescore query score.
+//
+// Parameters:
+//   - rescoreQueryWeight: The weight, 1 by default.
+//
+// Returns:
+//
+//	The updated es.rescoreQueryType object with the "rescore_query_weight" field set.
+func (r rescoreQueryType) RescoreQueryWeight(rescoreQueryWeight float64) rescoreQueryType {
+	r["rescore_query_weight"] = rescoreQueryWeight
+	return r
+}
+
+// ScoreMode sets how the weighted scores are combined.
+//
+// Parameters:
+//   - scoreMode: ScoreMode.Total, ScoreMode.Multiply, ScoreMode.Avg, ScoreMode.Max or ScoreMode.Min.
+//
+// Returns:
+//
+//	The updated es.rescoreQueryType object with the "score_mode" field set.
+func (r rescoreQueryType) ScoreMode(scoreMode ScoreMode.ScoreMode) rescoreQueryType {
+	r["score_mode"] = scoreMode
+	return r
+}
+
+// LearningToRank creates the learning_to_rank rescorer of an es.Rescorer.
+//
+// Example usage:
+//
+//	l := es.LearningToRank("product-ltr").Param("query_text", "running shoes")
+//	// l now contains {"model_id": "product-ltr", "params": {"query_text": "running shoes"}}
+//
+// Parameters:
+//   - modelID: The id of the deployed learning to rank model.
+//
+// Returns:
+//
+//	An es.learningToRankType object with the "model_id" field set.
+func LearningToRank(modelID string) learningToRankType {
+	return learningToRankType{
+		"model_id": modelID,
+	}
+}
+
+// Param sets a parameter of the feature extractor templates of the model.
+//
+// Parameters:
+//   - key: The parameter name.
+//   - value: The parameter value.
+//
+// Returns:
+//
+//	The updated es.learningToRankType object with the parameter added to "params".
+func (l learningToRankType) Param(key string, value any) learningToRankType {
+	params, ok := l["params"].(Object)
+	if !ok {
+		params = Object{}
+		l["params"] = params
+	}
+	params[key] = value
+	return l
+}
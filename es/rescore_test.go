@@ -0,0 +1,95 @@
+package es_test
+
+import (
+	"testing"
+
+	ScoreMode "github.com/Trendyol/es-query-builder/es/enums/score-mode"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Rescore   ////
+
+func Test_Rescorer_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.Rescorer)
+	assert.NotNil(t, es.RescoreQuery)
+	assert.NotNil(t, es.LearningToRank)
+}
+
+func Test_Rescorer_should_create_rescoreType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	rescorer := es.Rescorer(500)
+
+	// Then
+	assert.IsTypeString(t, "es.rescoreType", rescorer)
+	assert.IsTypeString(t, "es.rescoreQueryType", es.RescoreQuery(nil))
+	assert.IsTypeString(t, "es.learningToRankType", es.LearningToRank("model"))
+	bodyJSON := assert.MarshalWithoutError(t, rescorer)
+	assert.Equal(t, "{\"window_size\":500}", bodyJSON)
+}
+
+func Test_Rescorer_Query_should_create_json_with_query_rescorer(t *testing.T) {
+	t.Parallel()
+	// Given
+	rescorer := es.Rescorer(500).Query(
+		es.RescoreQuery(es.MatchPhrase("title", "running shoes")).
+			QueryWeight(0.7).
+			RescoreQueryWeight(1.2).
+			ScoreMode(ScoreMode.Multiply),
+	)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, rescorer)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"query_weight\":0.7,\"rescore_query\":{\"match_phrase\":{\"title\":{\"query\":\"running shoes\"}}},\"rescore_query_weight\":1.2,\"score_mode\":\"multiply\"},\"window_size\":500}", bodyJSON)
+}
+
+func Test_RescoreQuery_should_wrap_bool_query(t *testing.T) {
+	t.Parallel()
+	// Given
+	rescoreQuery := es.RescoreQuery(es.Bool().Should(es.Term("brand", "acme")))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, rescoreQuery)
+	assert.Equal(t, "{\"rescore_query\":{\"bool\":{\"should\":[{\"term\":{\"brand\":{\"value\":\"acme\"}}}]}}}", bodyJSON)
+}
+
+func Test_Rescorer_LearningToRank_should_create_json_with_model_and_params(t *testing.T) {
+	t.Parallel()
+	// Given
+	rescorer := es.Rescorer(100).LearningToRank(
+		es.LearningToRank("product-ltr").Param("query_text", "running shoes").Param("user_segment", 3),
+	)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, rescorer)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"learning_to_rank\":{\"model_id\":\"product-ltr\",\"params\":{\"query_text\":\"running shoes\",\"user_segment\":3}},\"window_size\":100}", bodyJSON)
+}
+
+func Test_Rescore_should_add_rescorers_into_Object_in_order(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.MatchAll()).
+		Rescore(es.Rescorer(500).Query(es.RescoreQuery(es.Term("a", "b")))).
+		Rescore(es.Rescorer(50).LearningToRank(es.LearningToRank("m")))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"match_all\":{}},\"rescore\":[{\"query\":{\"rescore_query\":{\"term\":{\"a\":{\"value\":\"b\"}}}},\"window_size\":500},{\"learning_to_rank\":{\"model_id\":\"m\"},\"window_size\":50}]}", bodyJSON)
+}
+
+func Test_Rescore_should_not_add_rescore_when_rescorers_empty(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.MatchAll()).Rescore()
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	assert.Equal(t, "{\"query\":{\"match_all\":{}}}", bodyJSON)
+}
@@ -0,0 +1,222 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Bucket is a single bucket of a multi-bucket aggregation. Numeric keys are kept as
+// json.Number to preserve precision of long values such as epoch millis.
+type Bucket struct {
+	Key                     any          `json:"key"`
+	From                    *float64     `json:"from,omitempty"`
+	To                      *float64     `json:"to,omitempty"`
+	Aggregations            Aggregations `json:"-"`
+	KeyAsString             string       `json:"key_as_string,omitempty"`
+	FromAsString            string       `json:"from_as_string,omitempty"`
+	ToAsString              string       `json:"to_as_string,omitempty"`
+	DocCount                int64        `json:"doc_count"`
+	DocCountErrorUpperBound int64        `json:"doc_count_error_upper_bound,omitempty"`
+}
+
+// Buckets is the "buckets" section of a multi-bucket aggregation. It accepts both the
+// array form and the keyed object form, in which case the object key becomes the bucket key.
+type Buckets []Bucket
+
+// TermsAggregation is the result of an es.TermsAgg or es.MultiTermsAgg aggregation.
+type TermsAggregation struct {
+	Meta                    map[string]any `json:"meta,omitempty"`
+	Buckets                 Buckets        `json:"buckets"`
+	DocCountErrorUpperBound int64          `json:"doc_count_error_upper_bound"`
+	SumOtherDocCount        int64          `json:"sum_other_doc_count"`
+}
+
+// BucketsAggregation is the result of histogram, date histogram, range,
+// date range and filters aggregations.
+type BucketsAggregation struct {
+	Meta    map[string]any `json:"meta,omitempty"`
+	Buckets Buckets        `json:"buckets"`
+}
+
+// SingleBucketAggregation is the result of filter, nested, reverse nested,
+// children and parent aggregations.
+type SingleBucketAggregation struct {
+	Meta         map[string]any `json:"meta,omitempty"`
+	Aggregations Aggregations   `json:"-"`
+	DocCount     int64          `json:"doc_count"`
+}
+
+var bucketFields = map[string]struct{}{
+	"key":                         {},
+	"key_as_string":               {},
+	"from":                        {},
+	"from_as_string":              {},
+	"to":                          {},
+	"to_as_string":                {},
+	"doc_count":                   {},
+	"doc_count_error_upper_bound": {},
+}
+
+var singleBucketFields = map[string]struct{}{
+	"doc_count": {},
+	"meta":      {},
+}
+
+// UnmarshalJSON decodes the bucket and collects every remaining object field as a sub-aggregation.
+func (b *Bucket) UnmarshalJSON(data []byte) error {
+	type plainBucket Bucket
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode((*plainBucket)(b)); err != nil {
+		return err
+	}
+	aggregations, err := subAggregations(data, bucketFields)
+	if err != nil {
+		return err
+	}
+	b.Aggregations = aggregations
+	return nil
+}
+
+// UnmarshalJSON decodes the bucket list from either its array or its keyed object form.
+func (b *Buckets) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return json.Unmarshal(data, (*[]Bucket)(b))
+	}
+	// The keyed form is walked token by token to keep the bucket order of the
+	// response, such as the order of keyed ranges or filters.
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+	buckets := make(Buckets, 0)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		var bucket Bucket
+		if err = decoder.Decode(&bucket); err != nil {
+			return err
+		}
+		if bucket.Key == nil {
+			bucket.Key = token.(string)
+		}
+		buckets = append(buckets, bucket)
+	}
+	*b = buckets
+	return nil
+}
+
+// UnmarshalJSON decodes the bucket and collects every remaining object field as a sub-aggregation.
+func (s *SingleBucketAggregation) UnmarshalJSON(data []byte) error {
+	type plainSingleBucketAggregation SingleBucketAggregation
+	if err := json.Unmarshal(data, (*plainSingleBucketAggregation)(s)); err != nil {
+		return err
+	}
+	aggregations, err := subAggregations(data, singleBucketFields)
+	if err != nil {
+		return err
+	}
+	s.Aggregations = aggregations
+	return nil
+}
+
+// KeyString returns the bucket key formatted as a string.
+//
+// "key_as_string" is preferred when Elasticsearch provides it, for example for
+// date histogram buckets, otherwise the raw key is formatted.
+//
+// Example usage:
+//
+//	for _, bucket := range byCategory.Buckets {
+//		fmt.Println(bucket.KeyString())
+//	}
+//
+// Returns:
+//
+//	The string representation of the bucket key.
+func (b *Bucket) KeyString() string {
+	if b.KeyAsString != "" {
+		return b.KeyAsString
+	}
+	if key, ok := b.Key.(string); ok {
+		return key
+	}
+	if b.Key == nil {
+		return ""
+	}
+	return fmt.Sprint(b.Key)
+}
+
+// Bucket finds a bucket by its string key, which is useful for filters and keyed aggregations.
+//
+// Example usage:
+//
+//	errors := messages.Bucket("errors")
+//
+// Parameters:
+//   - key: The key of the bucket as returned by es/response.Bucket.KeyString.
+//
+// Returns:
+//
+//	A pointer to the matching bucket, or nil if there is no such bucket.
+func (b Buckets) Bucket(key string) *Bucket {
+	for i := 0; i < len(b); i++ {
+		if b[i].KeyString() == key {
+			return &b[i]
+		}
+	}
+	return nil
+}
+
+// Bucket finds a bucket by its string key.
+//
+// Example usage:
+//
+//	electronics := byCategory.Bucket("electronics")
+//
+// Parameters:
+//   - key: The key of the bucket as returned by es/response.Bucket.KeyString.
+//
+// Returns:
+//
+//	A pointer to the matching bucket, or nil if there is no such bucket.
+func (t *TermsAggregation) Bucket(key string) *Bucket {
+	return t.Buckets.Bucket(key)
+}
+
+// Bucket finds a bucket by its string key.
+//
+// Example usage:
+//
+//	errors := messages.Bucket("errors")
+//
+// Parameters:
+//   - key: The key of the bucket as returned by es/response.Bucket.KeyString.
+//
+// Returns:
+//
+//	A pointer to the matching bucket, or nil if there is no such bucket.
+func (ba *BucketsAggregation) Bucket(key string) *Bucket {
+	return ba.Buckets.Bucket(key)
+}
+
+func subAggregations(data []byte, knownFields map[string]struct{}) (Aggregations, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	aggregations := Aggregations{}
+	for name, raw := range fields {
+		if _, known := knownFields[name]; known {
+			continue
+		}
+		if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '{' {
+			aggregations[name] = raw
+		}
+	}
+	return aggregations, nil
+}
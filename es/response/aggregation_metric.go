@@ -0,0 +1,55 @@
+package response
+
+import "encoding/json"
+
+// ValueAggregation is the result of single-value metric aggregations such as
+// avg, min, max, sum, cardinality and value_count. Value is nil when there
+// were no documents to compute it from.
+type ValueAggregation struct {
+	Value         *float64       `json:"value"`
+	Meta          map[string]any `json:"meta,omitempty"`
+	ValueAsString string         `json:"value_as_string,omitempty"`
+}
+
+// StatsAggregation is the result of an es.StatsAgg aggregation.
+type StatsAggregation struct {
+	Min         *float64       `json:"min"`
+	Max         *float64       `json:"max"`
+	Avg         *float64       `json:"avg"`
+	Meta        map[string]any `json:"meta,omitempty"`
+	MinAsString string         `json:"min_as_string,omitempty"`
+	MaxAsString string         `json:"max_as_string,omitempty"`
+	AvgAsString string         `json:"avg_as_string,omitempty"`
+	SumAsString string         `json:"sum_as_string,omitempty"`
+	Count       int64          `json:"count"`
+	Sum         float64        `json:"sum"`
+}
+
+// ExtendedStatsAggregation is the result of an es.ExtendedStatsAgg aggregation.
+type ExtendedStatsAggregation struct {
+	SumOfSquares           *float64            `json:"sum_of_squares"`
+	Variance               *float64            `json:"variance"`
+	VariancePopulation     *float64            `json:"variance_population"`
+	VarianceSampling       *float64            `json:"variance_sampling"`
+	StdDeviation           *float64            `json:"std_deviation"`
+	StdDeviationPopulation *float64            `json:"std_deviation_population"`
+	StdDeviationSampling   *float64            `json:"std_deviation_sampling"`
+	StdDeviationBounds     *StdDeviationBounds `json:"std_deviation_bounds,omitempty"`
+	StatsAggregation
+}
+
+// StdDeviationBounds holds the "std_deviation_bounds" of an extended stats aggregation.
+type StdDeviationBounds struct {
+	Upper           *float64 `json:"upper"`
+	Lower           *float64 `json:"lower"`
+	UpperPopulation *float64 `json:"upper_population"`
+	LowerPopulation *float64 `json:"lower_population"`
+	UpperSampling   *float64 `json:"upper_sampling"`
+	LowerSampling   *float64 `json:"lower_sampling"`
+}
+
+// TopHitsAggregation is the result of an es.TopHitsAgg aggregation with raw sources.
+type TopHitsAggregation struct {
+	Meta map[string]any        `json:"meta,omitempty"`
+	Hits Hits[json.RawMessage] `json:"hits"`
+}
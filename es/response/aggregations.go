@@ -0,0 +1,398 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrAggregationNotFound is returned when a named aggregation is not present in the response.
+var ErrAggregationNotFound = errors.New("aggregation not found")
+
+// Aggregations holds the raw "aggregations" section of a response, or the
+// sub-aggregations of a bucket, keyed by aggregation name. Each accessor decodes
+// the named entry into the result type matching its es builder.
+type Aggregations map[string]json.RawMessage
+
+// Terms decodes the named result of an es.TermsAgg aggregation.
+//
+// Example usage:
+//
+//	byCategory, err := searchResponse.Aggregations.Terms("by_category")
+//	for _, bucket := range byCategory.Buckets {
+//		fmt.Println(bucket.KeyString(), bucket.DocCount)
+//	}
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.TermsAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Terms(name string) (*TermsAggregation, error) {
+	return decodeAggregation[TermsAggregation](a, name)
+}
+
+// MultiTerms decodes the named result of an es.MultiTermsAgg aggregation.
+// Each bucket key is an array holding one value per term source.
+//
+// Example usage:
+//
+//	byGenreAndAuthor, err := searchResponse.Aggregations.MultiTerms("genre_and_author")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.TermsAggregation, or an error if it is missing or malformed.
+func (a Aggregations) MultiTerms(name string) (*TermsAggregation, error) {
+	return decodeAggregation[TermsAggregation](a, name)
+}
+
+// Histogram decodes the named result of an es.HistogramAgg aggregation.
+//
+// Example usage:
+//
+//	prices, err := searchResponse.Aggregations.Histogram("prices")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.BucketsAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Histogram(name string) (*BucketsAggregation, error) {
+	return decodeAggregation[BucketsAggregation](a, name)
+}
+
+// DateHistogram decodes the named result of an es.DateHistogramAgg aggregation.
+//
+// Example usage:
+//
+//	perDay, err := searchResponse.Aggregations.DateHistogram("per_day")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.BucketsAggregation, or an error if it is missing or malformed.
+func (a Aggregations) DateHistogram(name string) (*BucketsAggregation, error) {
+	return decodeAggregation[BucketsAggregation](a, name)
+}
+
+// Range decodes the named result of an es.RangeAgg aggregation.
+//
+// Example usage:
+//
+//	priceRanges, err := searchResponse.Aggregations.Range("price_ranges")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.BucketsAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Range(name string) (*BucketsAggregation, error) {
+	return decodeAggregation[BucketsAggregation](a, name)
+}
+
+// DateRange decodes the named result of an es.DateRangeAgg aggregation.
+//
+// Example usage:
+//
+//	periods, err := searchResponse.Aggregations.DateRange("periods")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.BucketsAggregation, or an error if it is missing or malformed.
+func (a Aggregations) DateRange(name string) (*BucketsAggregation, error) {
+	return decodeAggregation[BucketsAggregation](a, name)
+}
+
+// Filters decodes the named result of an es.FiltersAgg aggregation.
+// The key of each bucket is the name given to its filter.
+//
+// Example usage:
+//
+//	messages, err := searchResponse.Aggregations.Filters("messages")
+//	errors := messages.Bucket("errors")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.BucketsAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Filters(name string) (*BucketsAggregation, error) {
+	return decodeAggregation[BucketsAggregation](a, name)
+}
+
+// Filter decodes the named result of an es.FilterAgg aggregation.
+//
+// Example usage:
+//
+//	active, err := searchResponse.Aggregations.Filter("active")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.SingleBucketAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Filter(name string) (*SingleBucketAggregation, error) {
+	return decodeAggregation[SingleBucketAggregation](a, name)
+}
+
+// Nested decodes the named result of an es.NestedAgg aggregation.
+//
+// Example usage:
+//
+//	variants, err := searchResponse.Aggregations.Nested("variants")
+//	colors, err := variants.Aggregations.Terms("colors")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.SingleBucketAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Nested(name string) (*SingleBucketAggregation, error) {
+	return decodeAggregation[SingleBucketAggregation](a, name)
+}
+
+// ReverseNested decodes the named result of an es.ReverseNestedAgg aggregation.
+//
+// Example usage:
+//
+//	products, err := bucket.Aggregations.ReverseNested("products")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.SingleBucketAggregation, or an error if it is missing or malformed.
+func (a Aggregations) ReverseNested(name string) (*SingleBucketAggregation, error) {
+	return decodeAggregation[SingleBucketAggregation](a, name)
+}
+
+// Children decodes the named result of an es.ChildrenAgg aggregation.
+//
+// Example usage:
+//
+//	listings, err := bucket.Aggregations.Children("to_listings")
+//	avgPrice, err := listings.Aggregations.Avg("avg_price")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.SingleBucketAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Children(name string) (*SingleBucketAggregation, error) {
+	return decodeAggregation[SingleBucketAggregation](a, name)
+}
+
+// Parent decodes the named result of an es.ParentAgg aggregation.
+//
+// Example usage:
+//
+//	sellers, err := bucket.Aggregations.Parent("to_sellers")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.SingleBucketAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Parent(name string) (*SingleBucketAggregation, error) {
+	return decodeAggregation[SingleBucketAggregation](a, name)
+}
+
+// Avg decodes the named result of an es.AvgAgg aggregation.
+//
+// Example usage:
+//
+//	avgPrice, err := searchResponse.Aggregations.Avg("avg_price")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.ValueAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Avg(name string) (*ValueAggregation, error) {
+	return decodeAggregation[ValueAggregation](a, name)
+}
+
+// Min decodes the named result of an es.MinAgg aggregation.
+//
+// Example usage:
+//
+//	minPrice, err := searchResponse.Aggregations.Min("min_price")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.ValueAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Min(name string) (*ValueAggregation, error) {
+	return decodeAggregation[ValueAggregation](a, name)
+}
+
+// Max decodes the named result of an es.MaxAgg aggregation.
+//
+// Example usage:
+//
+//	maxPrice, err := searchResponse.Aggregations.Max("max_price")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.ValueAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Max(name string) (*ValueAggregation, error) {
+	return decodeAggregation[ValueAggregation](a, name)
+}
+
+// Sum decodes the named result of an es.SumAgg aggregation.
+//
+// Example usage:
+//
+//	revenue, err := searchResponse.Aggregations.Sum("revenue")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.ValueAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Sum(name string) (*ValueAggregation, error) {
+	return decodeAggregation[ValueAggregation](a, name)
+}
+
+// Cardinality decodes the named result of an es.CardinalityAgg aggregation.
+//
+// Example usage:
+//
+//	uniqueSellers, err := searchResponse.Aggregations.Cardinality("unique_sellers")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.ValueAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Cardinality(name string) (*ValueAggregation, error) {
+	return decodeAggregation[ValueAggregation](a, name)
+}
+
+// ValueCount decodes the named result of an es.ValueCountAgg aggregation.
+//
+// Example usage:
+//
+//	reviewCount, err := searchResponse.Aggregations.ValueCount("review_count")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.ValueAggregation, or an error if it is missing or malformed.
+func (a Aggregations) ValueCount(name string) (*ValueAggregation, error) {
+	return decodeAggregation[ValueAggregation](a, name)
+}
+
+// Stats decodes the named result of an es.StatsAgg aggregation.
+//
+// Example usage:
+//
+//	priceStats, err := searchResponse.Aggregations.Stats("price_stats")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.StatsAggregation, or an error if it is missing or malformed.
+func (a Aggregations) Stats(name string) (*StatsAggregation, error) {
+	return decodeAggregation[StatsAggregation](a, name)
+}
+
+// ExtendedStats decodes the named result of an es.ExtendedStatsAgg aggregation.
+//
+// Example usage:
+//
+//	priceStats, err := searchResponse.Aggregations.ExtendedStats("price_stats")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.ExtendedStatsAggregation, or an error if it is missing or malformed.
+func (a Aggregations) ExtendedStats(name string) (*ExtendedStatsAggregation, error) {
+	return decodeAggregation[ExtendedStatsAggregation](a, name)
+}
+
+// TopHits decodes the named result of an es.TopHitsAgg aggregation with raw sources,
+// use es/response.DecodeTopHits to decode the sources into a concrete type.
+//
+// Example usage:
+//
+//	latest, err := bucket.Aggregations.TopHits("latest")
+//
+// Parameters:
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.TopHitsAggregation, or an error if it is missing or malformed.
+func (a Aggregations) TopHits(name string) (*TopHitsAggregation, error) {
+	return decodeAggregation[TopHitsAggregation](a, name)
+}
+
+// DecodeTopHits decodes the named result of an es.TopHitsAgg aggregation
+// into hits whose sources are of type T.
+//
+// Example usage:
+//
+//	latest, err := response.DecodeTopHits[Product](bucket.Aggregations, "latest")
+//
+// Parameters:
+//   - aggregations: The aggregations containing the top hits result.
+//   - name: The name given to the aggregation with es.Agg.
+//
+// Returns:
+//
+//	The decoded es/response.Hits, or an error if it is missing or malformed.
+func DecodeTopHits[T any](aggregations Aggregations, name string) (*Hits[T], error) {
+	topHits, err := aggregations.TopHits(name)
+	if err != nil {
+		return nil, err
+	}
+	hits, err := convertHits[T](topHits.Hits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode aggregation %q: %w", name, err)
+	}
+	return hits, nil
+}
+
+func decodeAggregation[T any](aggregations Aggregations, name string) (*T, error) {
+	raw, ok := aggregations[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrAggregationNotFound, name)
+	}
+	var result T
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode aggregation %q: %w", name, err)
+	}
+	return &result, nil
+}
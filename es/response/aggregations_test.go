@@ -0,0 +1,372 @@
+package response_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+const aggregationsResponseJSON = `{
+  "hits": {"hits": []},
+  "aggregations": {
+    "by_category": {
+      "doc_count_error_upper_bound": 0, "sum_other_doc_count": 12, "meta": {"owner": "search"},
+      "buckets": [
+        {"key": "electronics", "doc_count": 30, "avg_price": {"value": 120.5}},
+        {"key": "books", "doc_count": 10, "avg_price": {"value": null}}
+      ]
+    },
+    "by_genre_and_author": {
+      "doc_count_error_upper_bound": 0, "sum_other_doc_count": 0,
+      "buckets": [{"key": ["fiction", "orwell"], "key_as_string": "fiction|orwell", "doc_count": 3}]
+    },
+    "per_day": {"buckets": [
+      {"key_as_string": "2024-01-01", "key": 1704067200000, "doc_count": 5},
+      {"key_as_string": "2024-01-02", "key": 1704153600000, "doc_count": 0}
+    ]},
+    "prices": {"buckets": [{"key": 0.0, "doc_count": 1}, {"key": 10.0, "doc_count": 2}]},
+    "price_ranges": {"buckets": [
+      {"key": "cheap", "to": 50.0, "doc_count": 4},
+      {"key": "*-100.0", "from": 50.0, "to": 100.0, "doc_count": 2}
+    ]},
+    "keyed_ranges": {"buckets": {
+      "expensive": {"from": 100.0, "doc_count": 1},
+      "cheap": {"to": 100.0, "doc_count": 9}
+    }},
+    "periods": {"buckets": [
+      {"key": "old", "to": 1.7e12, "to_as_string": "2023-11-14", "doc_count": 7}
+    ]},
+    "messages": {"buckets": {
+      "errors": {"doc_count": 34, "by_host": {"buckets": [{"key": "h1", "doc_count": 34}]}},
+      "warnings": {"doc_count": 439}
+    }},
+    "active": {"doc_count": 42, "max_price": {"value": 999.0}},
+    "variants": {"doc_count": 80, "colors": {"doc_count_error_upper_bound": 0, "sum_other_doc_count": 0,
+      "buckets": [{"key": "red", "doc_count": 50, "products": {"doc_count": 20}}]}},
+    "to_listings": {"doc_count": 12, "avg_price": {"value": 10.5}},
+    "to_sellers": {"doc_count": 3},
+    "avg_price": {"value": 75.25},
+    "min_price": {"value": 1.0, "value_as_string": "1.00"},
+    "max_price": {"value": null},
+    "revenue": {"value": 1234.5},
+    "unique_sellers": {"value": 17},
+    "review_count": {"value": 99},
+    "price_stats": {"count": 4, "min": 1.0, "max": 10.0, "avg": 5.0, "sum": 20.0},
+    "price_extended_stats": {"count": 2, "min": 1.0, "max": 3.0, "avg": 2.0, "sum": 4.0,
+      "sum_of_squares": 10.0, "variance": 1.0, "variance_population": 1.0, "variance_sampling": 2.0,
+      "std_deviation": 1.0, "std_deviation_population": 1.0, "std_deviation_sampling": 1.41,
+      "std_deviation_bounds": {"upper": 4.0, "lower": 0.0}},
+    "latest": {"hits": {"total": {"value": 1, "relation": "eq"}, "max_score": null,
+      "hits": [{"_index": "products", "_id": "9", "_score": null, "_source": {"name": "tv", "price": 500}}]}},
+    "broken": {"buckets": "not buckets"}
+  }
+}`
+
+func decodeAggregations(t *testing.T) response.Aggregations {
+	searchResponse, err := response.Unmarshal[product]([]byte(aggregationsResponseJSON))
+	assert.Nil(t, err)
+	return searchResponse.Aggregations
+}
+
+func Test_Aggregations_should_return_not_found_error_when_name_does_not_exist(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	terms, err := aggregations.Terms("unknown")
+
+	// Then
+	assert.True(t, terms == nil)
+	assert.True(t, errors.Is(err, response.ErrAggregationNotFound))
+	assert.Equal(t, "aggregation not found: \"unknown\"", err.Error())
+}
+
+func Test_Aggregations_should_return_error_when_shape_does_not_match(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	terms, err := aggregations.Terms("broken")
+
+	// Then
+	assert.True(t, terms == nil)
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, response.ErrAggregationNotFound))
+}
+
+func Test_Terms_should_decode_buckets_with_sub_aggregations(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	byCategory, err := aggregations.Terms("by_category")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), byCategory.SumOtherDocCount)
+	assert.Equal(t, "search", byCategory.Meta["owner"])
+	assert.Equal(t, 2, len(byCategory.Buckets))
+	assert.Equal(t, "electronics", byCategory.Buckets[0].KeyString())
+	assert.Equal(t, int64(30), byCategory.Buckets[0].DocCount)
+	avgPrice, err := byCategory.Buckets[0].Aggregations.Avg("avg_price")
+	assert.Nil(t, err)
+	assert.Equal(t, 120.5, *avgPrice.Value)
+	emptyAvg, err := byCategory.Bucket("books").Aggregations.Avg("avg_price")
+	assert.Nil(t, err)
+	assert.True(t, emptyAvg.Value == nil)
+}
+
+func Test_MultiTerms_should_decode_composite_keys(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	multiTerms, err := aggregations.MultiTerms("by_genre_and_author")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []any{"fiction", "orwell"}, multiTerms.Buckets[0].Key)
+	assert.Equal(t, "fiction|orwell", multiTerms.Buckets[0].KeyString())
+	assert.Equal(t, int64(3), multiTerms.Buckets[0].DocCount)
+}
+
+func Test_DateHistogram_should_decode_buckets(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	perDay, err := aggregations.DateHistogram("per_day")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(perDay.Buckets))
+	assert.Equal(t, "2024-01-02", perDay.Buckets[1].KeyString())
+	assert.Equal(t, int64(5), perDay.Bucket("2024-01-01").DocCount)
+}
+
+func Test_Histogram_should_decode_buckets(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	prices, err := aggregations.Histogram("prices")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "10.0", prices.Buckets[1].KeyString())
+	assert.Equal(t, int64(2), prices.Buckets[1].DocCount)
+}
+
+func Test_Range_should_decode_bounds(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	priceRanges, err := aggregations.Range("price_ranges")
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, priceRanges.Buckets[0].From == nil)
+	assert.Equal(t, 50.0, *priceRanges.Buckets[0].To)
+	assert.Equal(t, 50.0, *priceRanges.Buckets[1].From)
+	assert.Equal(t, int64(2), priceRanges.Bucket("*-100.0").DocCount)
+}
+
+func Test_Range_should_decode_keyed_buckets_in_response_order(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	keyedRanges, err := aggregations.Range("keyed_ranges")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(keyedRanges.Buckets))
+	assert.Equal(t, "expensive", keyedRanges.Buckets[0].KeyString())
+	assert.Equal(t, "cheap", keyedRanges.Buckets[1].KeyString())
+	assert.Equal(t, int64(1), keyedRanges.Bucket("expensive").DocCount)
+}
+
+func Test_DateRange_should_decode_string_bounds(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	periods, err := aggregations.DateRange("periods")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "2023-11-14", periods.Buckets[0].ToAsString)
+	assert.Equal(t, int64(7), periods.Buckets[0].DocCount)
+}
+
+func Test_Filters_should_decode_named_buckets_with_sub_aggregations(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	messages, err := aggregations.Filters("messages")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(439), messages.Bucket("warnings").DocCount)
+	byHost, err := messages.Bucket("errors").Aggregations.Terms("by_host")
+	assert.Nil(t, err)
+	assert.Equal(t, "h1", byHost.Buckets[0].KeyString())
+	assert.True(t, messages.Bucket("unknown") == nil)
+}
+
+func Test_Filter_should_decode_single_bucket_with_sub_aggregations(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	active, err := aggregations.Filter("active")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), active.DocCount)
+	maxPrice, err := active.Aggregations.Max("max_price")
+	assert.Nil(t, err)
+	assert.Equal(t, 999.0, *maxPrice.Value)
+}
+
+func Test_Nested_and_ReverseNested_should_decode_single_buckets(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	variants, err := aggregations.Nested("variants")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(80), variants.DocCount)
+	colors, err := variants.Aggregations.Terms("colors")
+	assert.Nil(t, err)
+	products, err := colors.Bucket("red").Aggregations.ReverseNested("products")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(20), products.DocCount)
+}
+
+func Test_Children_and_Parent_should_decode_single_buckets(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	listings, listingsErr := aggregations.Children("to_listings")
+	sellers, sellersErr := aggregations.Parent("to_sellers")
+
+	// Then
+	assert.Nil(t, listingsErr)
+	assert.Nil(t, sellersErr)
+	assert.Equal(t, int64(12), listings.DocCount)
+	assert.Equal(t, int64(3), sellers.DocCount)
+	avgPrice, err := listings.Aggregations.Avg("avg_price")
+	assert.Nil(t, err)
+	assert.Equal(t, 10.5, *avgPrice.Value)
+}
+
+func Test_single_value_metric_accessors_should_decode_values(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	avg, avgErr := aggregations.Avg("avg_price")
+	minimum, minErr := aggregations.Min("min_price")
+	maximum, maxErr := aggregations.Max("max_price")
+	sum, sumErr := aggregations.Sum("revenue")
+	cardinality, cardinalityErr := aggregations.Cardinality("unique_sellers")
+	valueCount, valueCountErr := aggregations.ValueCount("review_count")
+
+	// Then
+	assert.Nil(t, avgErr)
+	assert.Nil(t, minErr)
+	assert.Nil(t, maxErr)
+	assert.Nil(t, sumErr)
+	assert.Nil(t, cardinalityErr)
+	assert.Nil(t, valueCountErr)
+	assert.Equal(t, 75.25, *avg.Value)
+	assert.Equal(t, "1.00", minimum.ValueAsString)
+	assert.True(t, maximum.Value == nil)
+	assert.Equal(t, 1234.5, *sum.Value)
+	assert.Equal(t, 17.0, *cardinality.Value)
+	assert.Equal(t, 99.0, *valueCount.Value)
+}
+
+func Test_Stats_should_decode_stats(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	stats, err := aggregations.Stats("price_stats")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), stats.Count)
+	assert.Equal(t, 1.0, *stats.Min)
+	assert.Equal(t, 10.0, *stats.Max)
+	assert.Equal(t, 5.0, *stats.Avg)
+	assert.Equal(t, 20.0, stats.Sum)
+}
+
+func Test_ExtendedStats_should_decode_extended_stats(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	stats, err := aggregations.ExtendedStats("price_extended_stats")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), stats.Count)
+	assert.Equal(t, 10.0, *stats.SumOfSquares)
+	assert.Equal(t, 2.0, *stats.VarianceSampling)
+	assert.Equal(t, 1.41, *stats.StdDeviationSampling)
+	assert.Equal(t, 4.0, *stats.StdDeviationBounds.Upper)
+}
+
+func Test_DecodeTopHits_should_decode_typed_hits(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	latest, err := response.DecodeTopHits[product](aggregations, "latest")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), latest.Total.Value)
+	assert.Equal(t, "9", latest.Hits[0].ID)
+	assert.Equal(t, product{Name: "tv", Price: 500}, latest.Hits[0].Source)
+}
+
+func Test_DecodeTopHits_should_return_error_when_aggregation_does_not_exist(t *testing.T) {
+	t.Parallel()
+	// Given
+	aggregations := decodeAggregations(t)
+
+	// When
+	latest, err := response.DecodeTopHits[product](aggregations, "unknown")
+
+	// Then
+	assert.True(t, latest == nil)
+	assert.True(t, errors.Is(err, response.ErrAggregationNotFound))
+}
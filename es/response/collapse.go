@@ -0,0 +1,66 @@
+package response
+
+// CollapsedGroup is a group of a collapsed search response: the top hit of the
+// group, the value it was collapsed on and, when requested, its inner hits.
+type CollapsedGroup[T, U any] struct {
+	Key       any
+	InnerHits *Hits[U]
+	Hit       Hit[T]
+}
+
+// CollapseKey returns the value a hit was collapsed on, which Elasticsearch
+// returns in the "fields" of the hit.
+//
+// Example usage:
+//
+//	sellerID, ok := hit.CollapseKey("seller_id")
+//
+// Parameters:
+//   - field: The field given to es.FieldCollapse.
+//
+// Returns:
+//
+//	The value of the field, and false when the hit has no such field.
+func (h *Hit[T]) CollapseKey(field string) (any, bool) {
+	values := h.Fields[field]
+	if len(values) == 0 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+// CollapsedGroups pairs each hit of a collapsed search with its collapse key and
+// its named inner hits, whose sources are decoded into U.
+//
+// Example usage:
+//
+//	groups, err := response.CollapsedGroups[Product](searchResponse.Hits.Hits, "seller_id", "cheapest")
+//	for _, group := range groups {
+//		fmt.Println(group.Key, len(group.InnerHits.Hits))
+//	}
+//
+// Parameters:
+//   - hits: The hits of the collapsed search response.
+//   - field: The field given to es.FieldCollapse.
+//   - innerHitsName: The name of the inner hits of the collapse, or "" to skip them.
+//
+// Returns:
+//
+//	One es/response.CollapsedGroup per hit, in order, or an error if the inner
+//	hits of a group are missing or cannot be decoded.
+func CollapsedGroups[U, T any](hits []Hit[T], field, innerHitsName string) ([]CollapsedGroup[T, U], error) {
+	groups := make([]CollapsedGroup[T, U], 0, len(hits))
+	for i := 0; i < len(hits); i++ {
+		group := CollapsedGroup[T, U]{Hit: hits[i]}
+		group.Key, _ = hits[i].CollapseKey(field)
+		if innerHitsName != "" {
+			innerHits, err := DecodeInnerHits[U](hits[i], innerHitsName)
+			if err != nil {
+				return nil, err
+			}
+			group.InnerHits = innerHits
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
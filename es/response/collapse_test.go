@@ -0,0 +1,75 @@
+package response_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+type offer struct {
+	Price float64 `json:"price"`
+}
+
+// nolint:golint,lll
+const collapseResponseJSON = `{
+  "took": 4,
+  "hits": {"hits": [
+    {"_id": "1", "_source": {"name": "phone", "price": 500}, "fields": {"seller_id": ["s-1"]},
+      "inner_hits": {"cheapest": {"hits": {"total": {"value": 2, "relation": "eq"}, "hits": [{"_id": "1", "_source": {"price": 500}}, {"_id": "4", "_source": {"price": 510}}]}}}},
+    {"_id": "2", "_source": {"name": "case", "price": 20}, "fields": {"seller_id": ["s-2"]},
+      "inner_hits": {"cheapest": {"hits": {"total": {"value": 1, "relation": "eq"}, "hits": [{"_id": "2", "_source": {"price": 20}}]}}}}
+  ]}
+}`
+
+func Test_CollapsedGroups_should_pair_hits_with_keys_and_inner_hits(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, err := response.Unmarshal[product]([]byte(collapseResponseJSON))
+	assert.Nil(t, err)
+
+	// When
+	groups, groupsErr := response.CollapsedGroups[offer](searchResponse.Hits.Hits, "seller_id", "cheapest")
+
+	// Then
+	assert.Nil(t, groupsErr)
+	assert.Equal(t, 2, len(groups))
+	assert.Equal(t, "s-1", groups[0].Key)
+	assert.Equal(t, "phone", groups[0].Hit.Source.Name)
+	assert.Equal(t, 2, len(groups[0].InnerHits.Hits))
+	assert.Equal(t, 510.0, groups[0].InnerHits.Hits[1].Source.Price)
+	assert.Equal(t, "s-2", groups[1].Key)
+	assert.Equal(t, int64(1), groups[1].InnerHits.Total.Value)
+}
+
+func Test_CollapsedGroups_should_skip_inner_hits_without_name(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, err := response.Unmarshal[product]([]byte(collapseResponseJSON))
+	assert.Nil(t, err)
+
+	// When
+	groups, groupsErr := response.CollapsedGroups[offer](searchResponse.Hits.Hits, "brand", "")
+	_, missingErr := response.CollapsedGroups[offer](searchResponse.Hits.Hits, "seller_id", "missing")
+
+	// Then
+	assert.Nil(t, groupsErr)
+	assert.True(t, groups[0].Key == nil)
+	assert.True(t, groups[0].InnerHits == nil)
+	assert.Equal(t, "inner hits \"missing\" not found in hit \"1\"", missingErr.Error())
+}
+
+func Test_Hit_CollapseKey_should_return_first_field_value(t *testing.T) {
+	t.Parallel()
+	// Given
+	hit := response.Hit[product]{Fields: map[string][]any{"seller_id": {"s-1"}, "empty": {}}}
+
+	// When
+	key, ok := hit.CollapseKey("seller_id")
+	_, emptyOk := hit.CollapseKey("empty")
+
+	// Then
+	assert.True(t, ok)
+	assert.Equal(t, "s-1", key)
+	assert.False(t, emptyOk)
+}
@@ -0,0 +1,53 @@
+package response
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// CountResponse is the response of the _count API.
+type CountResponse struct {
+	Shards Shards `json:"_shards"`
+	Count  int64  `json:"count"`
+}
+
+// DecodeCount reads a count response from the given reader.
+//
+// Example usage:
+//
+//	res, err := client.Count(client.Count.WithBody(body))
+//	if err != nil {
+//		return err
+//	}
+//	defer res.Body.Close()
+//	countResponse, err := response.DecodeCount(res.Body)
+//
+// Parameters:
+//   - reader: An io.Reader providing the raw JSON count response.
+//
+// Returns:
+//
+//	A pointer to the decoded es/response.CountResponse, or an error if decoding fails.
+func DecodeCount(reader io.Reader) (*CountResponse, error) {
+	var countResponse CountResponse
+	if err := json.NewDecoder(reader).Decode(&countResponse); err != nil {
+		return nil, err
+	}
+	return &countResponse, nil
+}
+
+// UnmarshalCount decodes a raw JSON count response.
+//
+// Parameters:
+//   - data: The raw JSON count response.
+//
+// Returns:
+//
+//	A pointer to the decoded es/response.CountResponse, or an error if decoding fails.
+func UnmarshalCount(data []byte) (*CountResponse, error) {
+	var countResponse CountResponse
+	if err := json.Unmarshal(data, &countResponse); err != nil {
+		return nil, err
+	}
+	return &countResponse, nil
+}
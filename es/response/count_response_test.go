@@ -0,0 +1,44 @@
+package response_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+const countResponseJSON = `{"count": 42, "_shards": {"total": 2, "successful": 2, "skipped": 0, "failed": 0}}`
+
+func Test_DecodeCount_should_decode_count_and_shards(t *testing.T) {
+	t.Parallel()
+	// Given When
+	countResponse, err := response.DecodeCount(strings.NewReader(countResponseJSON))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), countResponse.Count)
+	assert.Equal(t, 2, countResponse.Shards.Total)
+	assert.Equal(t, 2, countResponse.Shards.Successful)
+}
+
+func Test_UnmarshalCount_should_decode_count(t *testing.T) {
+	t.Parallel()
+	// Given When
+	countResponse, err := response.UnmarshalCount([]byte(countResponseJSON))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), countResponse.Count)
+}
+
+func Test_DecodeCount_should_return_error_for_invalid_json(t *testing.T) {
+	t.Parallel()
+	// Given When
+	_, decodeErr := response.DecodeCount(strings.NewReader(`{"count":`))
+	_, unmarshalErr := response.UnmarshalCount([]byte(`{"count": "many"}`))
+
+	// Then
+	assert.NotNil(t, decodeErr)
+	assert.NotNil(t, unmarshalErr)
+}
@@ -0,0 +1,36 @@
+package response
+
+import (
+	"fmt"
+)
+
+// ErrorCause is the "error" object Elasticsearch returns for failed requests
+// and for the failed items of multi search and bulk responses.
+type ErrorCause struct {
+	CausedBy  *ErrorCause  `json:"caused_by,omitempty"`
+	Type      string       `json:"type"`
+	Reason    string       `json:"reason"`
+	Index     string       `json:"index,omitempty"`
+	RootCause []ErrorCause `json:"root_cause,omitempty"`
+}
+
+// Error formats the cause as "type: reason".
+func (e *ErrorCause) Error() string {
+	return e.Type + ": " + e.Reason
+}
+
+// ResponseError is a failed response or response item together with its HTTP status.
+type ResponseError struct {
+	Cause  ErrorCause `json:"error"`
+	Status int        `json:"status"`
+}
+
+// Error formats the error as "status type: reason".
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%d %s", e.Status, e.Cause.Error())
+}
+
+// Unwrap returns the es/response.ErrorCause of the error.
+func (e *ResponseError) Unwrap() error {
+	return &e.Cause
+}
@@ -0,0 +1,123 @@
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Hit is a single document returned in the "hits.hits" array of a search response.
+type Hit[T any] struct {
+	Source         T                    `json:"_source"`
+	Score          *float64             `json:"_score"`
+	Nested         *NestedIdentity      `json:"_nested,omitempty"`
+	Version        *int64               `json:"_version,omitempty"`
+	SeqNo          *int64               `json:"_seq_no,omitempty"`
+	PrimaryTerm    *int64               `json:"_primary_term,omitempty"`
+	Highlight      map[string][]string  `json:"highlight,omitempty"`
+	InnerHits      map[string]InnerHits `json:"inner_hits,omitempty"`
+	Fields         map[string][]any     `json:"fields,omitempty"`
+	Index          string               `json:"_index"`
+	ID             string               `json:"_id"`
+	Routing        string               `json:"_routing,omitempty"`
+	Sort           []any                `json:"sort,omitempty"`
+	MatchedQueries []string             `json:"matched_queries,omitempty"`
+}
+
+// InnerHits is a named "inner_hits" section of a hit. Sources are kept as raw JSON
+// since inner hits usually have a different shape than the parent document,
+// use es/response.DecodeInnerHits to decode them into a concrete type.
+type InnerHits struct {
+	Hits Hits[json.RawMessage] `json:"hits"`
+}
+
+// NestedIdentity locates a nested inner hit inside its parent document.
+type NestedIdentity struct {
+	Nested *NestedIdentity `json:"_nested,omitempty"`
+	Field  string          `json:"field"`
+	Offset int             `json:"offset"`
+}
+
+// HasMatchedQuery reports whether the named query was matched by this hit.
+//
+// Example usage:
+//
+//	if hit.HasMatchedQuery("title_match") {
+//		// ...
+//	}
+//
+// Parameters:
+//   - name: The "_name" given to the query clause.
+//
+// Returns:
+//
+//	true if the name is present in "matched_queries", false otherwise.
+func (h *Hit[T]) HasMatchedQuery(name string) bool {
+	for i := 0; i < len(h.MatchedQueries); i++ {
+		if h.MatchedQueries[i] == name {
+			return true
+		}
+	}
+	return false
+}
+
+// DecodeInnerHits decodes the named inner hits of a hit into hits whose sources are of type U.
+//
+// Example usage:
+//
+//	variants, err := response.DecodeInnerHits[Variant](hit, "variants")
+//
+// Parameters:
+//   - hit: The parent hit containing the "inner_hits" section.
+//   - name: The name of the inner hits, the nested path unless es.InnerHits().Name(...) was used.
+//
+// Returns:
+//
+//	The decoded es/response.Hits, or an error if the inner hits are missing or cannot be decoded.
+func DecodeInnerHits[U, T any](hit Hit[T], name string) (*Hits[U], error) {
+	innerHits, ok := hit.InnerHits[name]
+	if !ok {
+		return nil, fmt.Errorf("inner hits %q not found in hit %q", name, hit.ID)
+	}
+	return convertHits[U](innerHits.Hits)
+}
+
+func convertHits[U any](raw Hits[json.RawMessage]) (*Hits[U], error) {
+	hits := &Hits[U]{
+		Total:    raw.Total,
+		MaxScore: raw.MaxScore,
+		Hits:     make([]Hit[U], 0, len(raw.Hits)),
+	}
+	for i := 0; i < len(raw.Hits); i++ {
+		hit, err := convertHit[U](raw.Hits[i])
+		if err != nil {
+			return nil, err
+		}
+		hits.Hits = append(hits.Hits, hit)
+	}
+	return hits, nil
+}
+
+func convertHit[U any](raw Hit[json.RawMessage]) (Hit[U], error) {
+	hit := Hit[U]{
+		Score:          raw.Score,
+		Nested:         raw.Nested,
+		Version:        raw.Version,
+		SeqNo:          raw.SeqNo,
+		PrimaryTerm:    raw.PrimaryTerm,
+		Highlight:      raw.Highlight,
+		InnerHits:      raw.InnerHits,
+		Fields:         raw.Fields,
+		Index:          raw.Index,
+		ID:             raw.ID,
+		Routing:        raw.Routing,
+		Sort:           raw.Sort,
+		MatchedQueries: raw.MatchedQueries,
+	}
+	if len(raw.Source) == 0 {
+		return hit, nil
+	}
+	if err := json.Unmarshal(raw.Source, &hit.Source); err != nil {
+		return hit, fmt.Errorf("failed to decode source of hit %q: %w", raw.ID, err)
+	}
+	return hit, nil
+}
@@ -0,0 +1,86 @@
+package response_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+type variant struct {
+	Color string `json:"color"`
+}
+
+const innerHitsResponseJSON = `{
+  "hits": {
+    "total": {"value": 1, "relation": "eq"},
+    "hits": [
+      {"_index": "products", "_id": "1", "_score": 2.0, "_source": {"name": "shirt", "price": 20},
+       "inner_hits": {
+         "variants": {"hits": {"total": {"value": 2, "relation": "eq"}, "max_score": 1.0, "hits": [
+           {"_index": "products", "_id": "1", "_nested": {"field": "variants", "offset": 0}, "_score": 1.0,
+            "_source": {"color": "red"}},
+           {"_index": "products", "_id": "1", "_nested": {"field": "variants", "offset": 1}, "_score": 0.5,
+            "_source": {"color": "blue"}}
+         ]}},
+         "broken": {"hits": {"hits": [{"_id": "1", "_source": {"color": 42}}]}}
+       }}
+    ]
+  }
+}`
+
+func Test_DecodeInnerHits_should_decode_named_inner_hits(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, err := response.Unmarshal[product]([]byte(innerHitsResponseJSON))
+	assert.Nil(t, err)
+
+	// When
+	variants, err := response.DecodeInnerHits[variant](searchResponse.Hits.Hits[0], "variants")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), variants.Total.Value)
+	assert.Equal(t, 2, len(variants.Hits))
+	assert.Equal(t, variant{Color: "red"}, variants.Hits[0].Source)
+	assert.Equal(t, variant{Color: "blue"}, variants.Hits[1].Source)
+	assert.Equal(t, "variants", variants.Hits[1].Nested.Field)
+	assert.Equal(t, 1, variants.Hits[1].Nested.Offset)
+	assert.Equal(t, 0.5, *variants.Hits[1].Score)
+}
+
+func Test_DecodeInnerHits_should_return_error_when_name_does_not_exist(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, _ := response.Unmarshal[product]([]byte(innerHitsResponseJSON))
+
+	// When
+	variants, err := response.DecodeInnerHits[variant](searchResponse.Hits.Hits[0], "unknown")
+
+	// Then
+	assert.True(t, variants == nil)
+	assert.Equal(t, "inner hits \"unknown\" not found in hit \"1\"", err.Error())
+}
+
+func Test_DecodeInnerHits_should_return_error_when_source_cannot_be_decoded(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, _ := response.Unmarshal[product]([]byte(innerHitsResponseJSON))
+
+	// When
+	variants, err := response.DecodeInnerHits[variant](searchResponse.Hits.Hits[0], "broken")
+
+	// Then
+	assert.True(t, variants == nil)
+	assert.NotNil(t, err)
+}
+
+func Test_HasMatchedQuery_should_report_matched_query_names(t *testing.T) {
+	t.Parallel()
+	// Given
+	hit := response.Hit[product]{MatchedQueries: []string{"by_name", "by_brand"}}
+
+	// When Then
+	assert.True(t, hit.HasMatchedQuery("by_brand"))
+	assert.False(t, hit.HasMatchedQuery("by_price"))
+}
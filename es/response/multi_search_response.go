@@ -0,0 +1,100 @@
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// MultiSearchResponse is the response of the _msearch API. Each entry of
+// Responses holds the raw response of the search at the same position of the
+// request, which es/response.MultiSearchResult decodes.
+type MultiSearchResponse struct {
+	Responses []json.RawMessage `json:"responses"`
+	Took      int64             `json:"took"`
+}
+
+// DecodeMultiSearch reads a multi search response from the given reader.
+//
+// Example usage:
+//
+//	res, err := client.Msearch(&body)
+//	if err != nil {
+//		return err
+//	}
+//	defer res.Body.Close()
+//	multiSearchResponse, err := response.DecodeMultiSearch(res.Body)
+//
+// Parameters:
+//   - reader: An io.Reader providing the raw JSON multi search response.
+//
+// Returns:
+//
+//	A pointer to the decoded es/response.MultiSearchResponse, or an error if decoding fails.
+func DecodeMultiSearch(reader io.Reader) (*MultiSearchResponse, error) {
+	var multiSearchResponse MultiSearchResponse
+	if err := json.NewDecoder(reader).Decode(&multiSearchResponse); err != nil {
+		return nil, err
+	}
+	return &multiSearchResponse, nil
+}
+
+// UnmarshalMultiSearch decodes a raw JSON multi search response.
+//
+// Parameters:
+//   - data: The raw JSON multi search response.
+//
+// Returns:
+//
+//	A pointer to the decoded es/response.MultiSearchResponse, or an error if decoding fails.
+func UnmarshalMultiSearch(data []byte) (*MultiSearchResponse, error) {
+	var multiSearchResponse MultiSearchResponse
+	if err := json.Unmarshal(data, &multiSearchResponse); err != nil {
+		return nil, err
+	}
+	return &multiSearchResponse, nil
+}
+
+// Len returns the number of responses, which matches the number of searches in the request.
+func (r *MultiSearchResponse) Len() int {
+	return len(r.Responses)
+}
+
+// MultiSearchResult decodes the response of the search at the given position
+// of a multi search request into an es/response.SearchResponse whose hit
+// sources are of type T. Each search can use its own source type.
+//
+// Example usage:
+//
+//	products, err := response.MultiSearchResult[Product](multiSearchResponse, 0)
+//	reviews, err := response.MultiSearchResult[Review](multiSearchResponse, 1)
+//
+// Parameters:
+//   - r: The decoded es/response.MultiSearchResponse.
+//   - index: The position of the search in the request.
+//
+// Returns:
+//
+//	The decoded es/response.SearchResponse, an *es/response.ResponseError when the
+//	search failed, or an error when index is out of range or decoding fails.
+func MultiSearchResult[T any](r *MultiSearchResponse, index int) (*SearchResponse[T], error) {
+	if index < 0 || index >= len(r.Responses) {
+		return nil, fmt.Errorf("multi search response index %d out of range [0, %d)", index, len(r.Responses))
+	}
+	var result struct {
+		SearchResponse[T]
+		Error  json.RawMessage `json:"error"`
+		Status int             `json:"status"`
+	}
+	if err := json.Unmarshal(r.Responses[index], &result); err != nil {
+		return nil, err
+	}
+	if len(result.Error) > 0 && string(result.Error) != "null" {
+		var cause ErrorCause
+		if err := json.Unmarshal(result.Error, &cause); err != nil {
+			return nil, err
+		}
+		return nil, &ResponseError{Cause: cause, Status: result.Status}
+	}
+	return &result.SearchResponse, nil
+}
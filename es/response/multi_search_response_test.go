@@ -0,0 +1,92 @@
+package response_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+type review struct {
+	Text string `json:"text"`
+}
+
+const multiSearchResponseJSON = `{
+  "took": 12,
+  "responses": [
+    {"took": 3, "timed_out": false, "status": 200, "hits": {"total": {"value": 1, "relation": "eq"},
+      "hits": [{"_id": "1", "_source": {"name": "phone", "price": 99.9}}]}},
+    {"error": {"type": "index_not_found_exception", "reason": "no such index [reviewz]", "index": "reviewz",
+      "root_cause": [{"type": "index_not_found_exception", "reason": "no such index [reviewz]"}]}, "status": 404},
+    {"took": 1, "timed_out": false, "status": 200, "hits": {"hits": [{"_id": "9", "_source": {"text": "great error handling", "error": "none"}}]}}
+  ]
+}`
+
+func Test_UnmarshalMultiSearch_should_split_responses_in_order(t *testing.T) {
+	t.Parallel()
+	// Given
+	multiSearchResponse, err := response.UnmarshalMultiSearch([]byte(multiSearchResponseJSON))
+	assert.Nil(t, err)
+
+	// When
+	products, productsErr := response.MultiSearchResult[product](multiSearchResponse, 0)
+	reviews, reviewsErr := response.MultiSearchResult[review](multiSearchResponse, 2)
+
+	// Then
+	assert.Equal(t, int64(12), multiSearchResponse.Took)
+	assert.Equal(t, 3, multiSearchResponse.Len())
+	assert.Nil(t, productsErr)
+	assert.Nil(t, reviewsErr)
+	assert.Equal(t, []product{{Name: "phone", Price: 99.9}}, products.Sources())
+	assert.Equal(t, []review{{Text: "great error handling"}}, reviews.Sources())
+}
+
+func Test_MultiSearchResult_should_return_response_error_for_failed_search(t *testing.T) {
+	t.Parallel()
+	// Given
+	multiSearchResponse, err := response.DecodeMultiSearch(strings.NewReader(multiSearchResponseJSON))
+	assert.Nil(t, err)
+
+	// When
+	result, resultErr := response.MultiSearchResult[review](multiSearchResponse, 1)
+
+	// Then
+	assert.True(t, result == nil)
+	var responseError *response.ResponseError
+	assert.True(t, errors.As(resultErr, &responseError))
+	assert.Equal(t, 404, responseError.Status)
+	assert.Equal(t, "reviewz", responseError.Cause.Index)
+	assert.Equal(t, 1, len(responseError.Cause.RootCause))
+	assert.Equal(t, "404 index_not_found_exception: no such index [reviewz]", resultErr.Error())
+	var cause *response.ErrorCause
+	assert.True(t, errors.As(resultErr, &cause))
+	assert.Equal(t, "index_not_found_exception", cause.Type)
+}
+
+func Test_MultiSearchResult_should_return_error_for_index_out_of_range(t *testing.T) {
+	t.Parallel()
+	// Given
+	multiSearchResponse, err := response.UnmarshalMultiSearch([]byte(multiSearchResponseJSON))
+	assert.Nil(t, err)
+
+	// When
+	_, negativeErr := response.MultiSearchResult[product](multiSearchResponse, -1)
+	_, tooLargeErr := response.MultiSearchResult[product](multiSearchResponse, 3)
+
+	// Then
+	assert.Equal(t, "multi search response index -1 out of range [0, 3)", negativeErr.Error())
+	assert.Equal(t, "multi search response index 3 out of range [0, 3)", tooLargeErr.Error())
+}
+
+func Test_DecodeMultiSearch_should_return_error_when_json_is_invalid(t *testing.T) {
+	t.Parallel()
+	// Given When
+	_, decodeErr := response.DecodeMultiSearch(strings.NewReader(`{"responses":`))
+	_, unmarshalErr := response.UnmarshalMultiSearch([]byte(`{"responses": {}}`))
+
+	// Then
+	assert.NotNil(t, decodeErr)
+	assert.NotNil(t, unmarshalErr)
+}
@@ -0,0 +1,167 @@
+package response
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// TotalHitsRelation describes how the "hits.total.value" of a search response
+// should be interpreted.
+//
+// Constants:
+//   - Equal: The value is the exact number of matching documents.
+//   - GreaterThanOrEqual: The value is a lower bound of the matching documents.
+type TotalHitsRelation string
+
+const (
+	// Equal indicates that "hits.total.value" is accurate.
+	Equal TotalHitsRelation = "eq"
+
+	// GreaterThanOrEqual indicates that "hits.total.value" is a lower bound.
+	GreaterThanOrEqual TotalHitsRelation = "gte"
+)
+
+func (relation TotalHitsRelation) String() string {
+	return string(relation)
+}
+
+// SearchResponse is the typed representation of an Elasticsearch search response
+// where each hit's "_source" is decoded into T.
+type SearchResponse[T any] struct {
+	Aggregations Aggregations `json:"aggregations,omitempty"`
+	Suggest      Suggest      `json:"suggest,omitempty"`
+	Shards       Shards       `json:"_shards"`
+	Hits         Hits[T]      `json:"hits"`
+	PitID        string       `json:"pit_id,omitempty"`
+	ScrollID     string       `json:"_scroll_id,omitempty"`
+	Took         int64        `json:"took"`
+	TimedOut     bool         `json:"timed_out"`
+}
+
+// Hits is the "hits" section of a search response.
+type Hits[T any] struct {
+	Total    *TotalHits `json:"total,omitempty"`
+	MaxScore *float64   `json:"max_score"`
+	Hits     []Hit[T]   `json:"hits"`
+}
+
+// TotalHits is the "hits.total" section of a search response.
+type TotalHits struct {
+	Relation TotalHitsRelation `json:"relation"`
+	Value    int64             `json:"value"`
+}
+
+// Shards is the "_shards" section of a response.
+type Shards struct {
+	Failures   []ShardFailure `json:"failures,omitempty"`
+	Total      int            `json:"total"`
+	Successful int            `json:"successful"`
+	Skipped    int            `json:"skipped"`
+	Failed     int            `json:"failed"`
+}
+
+// ShardFailure describes a single shard that failed to execute the request.
+type ShardFailure struct {
+	Reason map[string]any `json:"reason,omitempty"`
+	Index  string         `json:"index,omitempty"`
+	Node   string         `json:"node,omitempty"`
+	Status string         `json:"status,omitempty"`
+	Shard  int            `json:"shard"`
+}
+
+// Decode reads a search response from the given reader and decodes it into an
+// es/response.SearchResponse whose hit sources are of type T.
+//
+// Example usage:
+//
+//	res, err := client.Search(client.Search.WithBody(body))
+//	if err != nil {
+//		return err
+//	}
+//	defer res.Body.Close()
+//	searchResponse, err := response.Decode[Product](res.Body)
+//
+// Parameters:
+//   - reader: An io.Reader providing the raw JSON search response.
+//
+// Returns:
+//
+//	A pointer to the decoded es/response.SearchResponse, or an error if decoding fails.
+func Decode[T any](reader io.Reader) (*SearchResponse[T], error) {
+	var searchResponse SearchResponse[T]
+	if err := json.NewDecoder(reader).Decode(&searchResponse); err != nil {
+		return nil, err
+	}
+	return &searchResponse, nil
+}
+
+// Unmarshal decodes a raw JSON search response into an es/response.SearchResponse
+// whose hit sources are of type T.
+//
+// Example usage:
+//
+//	searchResponse, err := response.Unmarshal[Product](body)
+//
+// Parameters:
+//   - data: The raw JSON search response.
+//
+// Returns:
+//
+//	A pointer to the decoded es/response.SearchResponse, or an error if decoding fails.
+func Unmarshal[T any](data []byte) (*SearchResponse[T], error) {
+	var searchResponse SearchResponse[T]
+	if err := json.Unmarshal(data, &searchResponse); err != nil {
+		return nil, err
+	}
+	return &searchResponse, nil
+}
+
+// TotalHits returns the value of "hits.total" or zero when it is not tracked.
+//
+// Example usage:
+//
+//	count := searchResponse.TotalHits()
+//
+// Returns:
+//
+//	The number of matching documents reported by Elasticsearch.
+func (r *SearchResponse[T]) TotalHits() int64 {
+	if r.Hits.Total == nil {
+		return 0
+	}
+	return r.Hits.Total.Value
+}
+
+// Sources collects the decoded "_source" of every hit in order.
+//
+// Example usage:
+//
+//	products := searchResponse.Sources()
+//
+// Returns:
+//
+//	A slice containing the source document of each hit.
+func (r *SearchResponse[T]) Sources() []T {
+	sources := make([]T, 0, len(r.Hits.Hits))
+	for i := 0; i < len(r.Hits.Hits); i++ {
+		sources = append(sources, r.Hits.Hits[i].Source)
+	}
+	return sources
+}
+
+// LastSort returns the sort values of the last hit, which can be passed to
+// es.Object.SearchAfter to fetch the next page.
+//
+// Example usage:
+//
+//	next := query.SearchAfter(searchResponse.LastSort()...)
+//
+// Returns:
+//
+//	The "sort" values of the last hit, or nil when there are no hits.
+func (r *SearchResponse[T]) LastSort() []any {
+	if len(r.Hits.Hits) == 0 {
+		return nil
+	}
+	return r.Hits.Hits[len(r.Hits.Hits)-1].Sort
+}
@@ -0,0 +1,165 @@
+package response_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+type product struct {
+	Name  string  `json:"name"`
+	Price float64 `json:"price"`
+}
+
+const searchResponseJSON = `{
+  "took": 5,
+  "timed_out": false,
+  "_shards": {"total": 2, "successful": 1, "skipped": 0, "failed": 1,
+    "failures": [{"shard": 1, "index": "products", "node": "n1", "reason": {"type": "query_shard_exception"}}]},
+  "hits": {
+    "total": {"value": 10000, "relation": "gte"},
+    "max_score": 1.5,
+    "hits": [
+      {"_index": "products", "_id": "1", "_score": 1.5, "_source": {"name": "phone", "price": 99.9},
+       "sort": [1700000000, "1"], "highlight": {"name": ["<em>phone</em>"]}, "matched_queries": ["by_name"],
+       "fields": {"price": [99.9]}},
+      {"_index": "products", "_id": "2", "_score": null, "_source": {"name": "case", "price": 9.5},
+       "sort": [1700000001, "2"]}
+    ]
+  }
+}`
+
+func Test_Unmarshal_should_decode_search_response(t *testing.T) {
+	t.Parallel()
+	// Given When
+	searchResponse, err := response.Unmarshal[product]([]byte(searchResponseJSON))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), searchResponse.Took)
+	assert.False(t, searchResponse.TimedOut)
+	assert.Equal(t, 2, searchResponse.Shards.Total)
+	assert.Equal(t, 1, searchResponse.Shards.Failed)
+	assert.Equal(t, 1, len(searchResponse.Shards.Failures))
+	assert.Equal(t, "products", searchResponse.Shards.Failures[0].Index)
+	assert.Equal(t, int64(10000), searchResponse.Hits.Total.Value)
+	assert.Equal(t, response.GreaterThanOrEqual, searchResponse.Hits.Total.Relation)
+	assert.Equal(t, 1.5, *searchResponse.Hits.MaxScore)
+	assert.Equal(t, 2, len(searchResponse.Hits.Hits))
+}
+
+func Test_Unmarshal_should_decode_hit_metadata(t *testing.T) {
+	t.Parallel()
+	// Given When
+	searchResponse, err := response.Unmarshal[product]([]byte(searchResponseJSON))
+
+	// Then
+	assert.Nil(t, err)
+	hit := searchResponse.Hits.Hits[0]
+	assert.Equal(t, "products", hit.Index)
+	assert.Equal(t, "1", hit.ID)
+	assert.Equal(t, 1.5, *hit.Score)
+	assert.Equal(t, product{Name: "phone", Price: 99.9}, hit.Source)
+	assert.Equal(t, []any{float64(1700000000), "1"}, hit.Sort)
+	assert.Equal(t, []string{"<em>phone</em>"}, hit.Highlight["name"])
+	assert.Equal(t, []string{"by_name"}, hit.MatchedQueries)
+	assert.Equal(t, []any{99.9}, hit.Fields["price"])
+	assert.True(t, searchResponse.Hits.Hits[1].Score == nil)
+}
+
+func Test_Unmarshal_should_return_error_when_json_is_invalid(t *testing.T) {
+	t.Parallel()
+	// Given When
+	searchResponse, err := response.Unmarshal[product]([]byte(`{"hits":`))
+
+	// Then
+	assert.NotNil(t, err)
+	assert.True(t, searchResponse == nil)
+}
+
+func Test_Decode_should_decode_search_response_from_reader(t *testing.T) {
+	t.Parallel()
+	// Given When
+	searchResponse, err := response.Decode[product](strings.NewReader(searchResponseJSON))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(searchResponse.Hits.Hits))
+	assert.Equal(t, "case", searchResponse.Hits.Hits[1].Source.Name)
+}
+
+func Test_Decode_should_return_error_when_reader_is_invalid(t *testing.T) {
+	t.Parallel()
+	// Given When
+	searchResponse, err := response.Decode[product](strings.NewReader("not json"))
+
+	// Then
+	assert.NotNil(t, err)
+	assert.True(t, searchResponse == nil)
+}
+
+func Test_TotalHits_should_return_total_value(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, _ := response.Unmarshal[product]([]byte(searchResponseJSON))
+
+	// When Then
+	assert.Equal(t, int64(10000), searchResponse.TotalHits())
+}
+
+func Test_TotalHits_should_return_zero_when_total_is_not_tracked(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, _ := response.Unmarshal[product]([]byte(`{"hits":{"hits":[]}}`))
+
+	// When Then
+	assert.Equal(t, int64(0), searchResponse.TotalHits())
+}
+
+func Test_Sources_should_return_sources_in_order(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, _ := response.Unmarshal[product]([]byte(searchResponseJSON))
+
+	// When
+	sources := searchResponse.Sources()
+
+	// Then
+	assert.Equal(t, []product{{Name: "phone", Price: 99.9}, {Name: "case", Price: 9.5}}, sources)
+}
+
+func Test_LastSort_should_return_sort_values_of_last_hit(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, _ := response.Unmarshal[product]([]byte(searchResponseJSON))
+
+	// When Then
+	assert.Equal(t, []any{float64(1700000001), "2"}, searchResponse.LastSort())
+}
+
+func Test_LastSort_should_return_nil_when_there_are_no_hits(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, _ := response.Unmarshal[product]([]byte(`{"hits":{"hits":[]}}`))
+
+	// When Then
+	assert.True(t, searchResponse.LastSort() == nil)
+}
+
+func Test_TotalHitsRelation_String(t *testing.T) {
+	tests := []struct {
+		relation response.TotalHitsRelation
+		result   string
+	}{
+		{response.Equal, "eq"},
+		{response.GreaterThanOrEqual, "gte"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.result, func(t *testing.T) {
+			assert.Equal(t, test.result, test.relation.String())
+		})
+	}
+}
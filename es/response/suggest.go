@@ -0,0 +1,122 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrSuggestionNotFound is returned when a named suggestion is not present in the response.
+var ErrSuggestionNotFound = errors.New("suggestion not found")
+
+// Suggest holds the raw "suggest" section of a search response keyed by
+// suggestion name. Each accessor decodes the named entry into the result type
+// matching its es suggester.
+type Suggest map[string]json.RawMessage
+
+// SuggestEntry is the result for one part of the suggested text: a term for the
+// term suggester, the whole text for the phrase and completion suggesters.
+type SuggestEntry[O any] struct {
+	Text    string `json:"text"`
+	Options []O    `json:"options"`
+	Offset  int    `json:"offset"`
+	Length  int    `json:"length"`
+}
+
+// TermOption is a suggestion of the term suggester.
+type TermOption struct {
+	Text  string  `json:"text"`
+	Score float64 `json:"score"`
+	Freq  int64   `json:"freq"`
+}
+
+// PhraseOption is a suggestion of the phrase suggester. CollateMatch is only set
+// when the suggester collates with pruning enabled.
+type PhraseOption struct {
+	CollateMatch *bool   `json:"collate_match,omitempty"`
+	Text         string  `json:"text"`
+	Highlighted  string  `json:"highlighted,omitempty"`
+	Score        float64 `json:"score"`
+}
+
+// CompletionOption is a suggestion of the completion suggester together with
+// the document it comes from, whose "_source" is decoded into T.
+type CompletionOption[T any] struct {
+	Source   T                   `json:"_source"`
+	Contexts map[string][]string `json:"contexts,omitempty"`
+	Text     string              `json:"text"`
+	Index    string              `json:"_index"`
+	ID       string              `json:"_id"`
+	Score    float64             `json:"_score"`
+}
+
+// Term decodes the named result of an es.TermSuggester suggestion, with one
+// entry per term of the text.
+//
+// Example usage:
+//
+//	entries, err := searchResponse.Suggest.Term("title_fix")
+//	for _, entry := range entries {
+//		if len(entry.Options) > 0 {
+//			fmt.Println(entry.Text, "->", entry.Options[0].Text)
+//		}
+//	}
+//
+// Parameters:
+//   - name: The name given to the suggestion with es.Suggestion.
+//
+// Returns:
+//
+//	The decoded entries, or an error if the suggestion is missing or malformed.
+func (s Suggest) Term(name string) ([]SuggestEntry[TermOption], error) {
+	return decodeSuggestion[TermOption](s, name)
+}
+
+// Phrase decodes the named result of an es.PhraseSuggester suggestion.
+//
+// Example usage:
+//
+//	entries, err := searchResponse.Suggest.Phrase("did_you_mean")
+//
+// Parameters:
+//   - name: The name given to the suggestion with es.Suggestion.
+//
+// Returns:
+//
+//	The decoded entries, or an error if the suggestion is missing or malformed.
+func (s Suggest) Phrase(name string) ([]SuggestEntry[PhraseOption], error) {
+	return decodeSuggestion[PhraseOption](s, name)
+}
+
+// Completion decodes the named result of an es.CompletionSuggester suggestion
+// into options whose sources are of type T.
+//
+// Example usage:
+//
+//	entries, err := response.Completion[Product](searchResponse.Suggest, "autocomplete")
+//	for _, option := range entries[0].Options {
+//		fmt.Println(option.Text, option.Source.Name)
+//	}
+//
+// Parameters:
+//   - suggest: The "suggest" section of the response.
+//   - name: The name given to the suggestion with es.Suggestion.
+//
+// Returns:
+//
+//	The decoded entries, or an error if the suggestion is missing or malformed.
+func Completion[T any](suggest Suggest, name string) ([]SuggestEntry[CompletionOption[T]], error) {
+	return decodeSuggestion[CompletionOption[T]](suggest, name)
+}
+
+func decodeSuggestion[O any](suggest Suggest, name string) ([]SuggestEntry[O], error) {
+	raw, ok := suggest[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrSuggestionNotFound, name)
+	}
+	var entries []SuggestEntry[O]
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode suggestion %q: %w", name, err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,77 @@
+package response_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+// nolint:golint,lll
+const suggestResponseJSON = `{
+  "took": 2,
+  "hits": {"hits": []},
+  "suggest": {
+    "title_fix": [
+      {"text": "iphnoe", "offset": 0, "length": 6, "options": [{"text": "iphone", "score": 0.83, "freq": 120}]},
+      {"text": "case", "offset": 7, "length": 4, "options": []}
+    ],
+    "did_you_mean": [
+      {"text": "noble prize", "offset": 0, "length": 11, "options": [
+        {"text": "nobel prize", "highlighted": "<em>nobel</em> prize", "score": 0.55, "collate_match": true}
+      ]}
+    ],
+    "autocomplete": [
+      {"text": "iph", "offset": 0, "length": 3, "options": [
+        {"text": "iPhone 15", "_index": "products", "_id": "1", "_score": 2.0, "_source": {"name": "iPhone 15", "price": 999}, "contexts": {"brand": ["apple"]}}
+      ]}
+    ]
+  }
+}`
+
+func Test_Suggest_should_decode_each_suggester(t *testing.T) {
+	t.Parallel()
+	// Given
+	searchResponse, err := response.Unmarshal[product]([]byte(suggestResponseJSON))
+	assert.Nil(t, err)
+
+	// When
+	terms, termErr := searchResponse.Suggest.Term("title_fix")
+	phrases, phraseErr := searchResponse.Suggest.Phrase("did_you_mean")
+	completions, completionErr := response.Completion[product](searchResponse.Suggest, "autocomplete")
+
+	// Then
+	assert.Nil(t, termErr)
+	assert.Nil(t, phraseErr)
+	assert.Nil(t, completionErr)
+	assert.Equal(t, 2, len(terms))
+	assert.Equal(t, "iphnoe", terms[0].Text)
+	assert.Equal(t, 6, terms[0].Length)
+	assert.Equal(t, response.TermOption{Text: "iphone", Score: 0.83, Freq: 120}, terms[0].Options[0])
+	assert.Equal(t, 7, terms[1].Offset)
+	assert.Equal(t, 0, len(terms[1].Options))
+	assert.Equal(t, "<em>nobel</em> prize", phrases[0].Options[0].Highlighted)
+	assert.True(t, *phrases[0].Options[0].CollateMatch)
+	option := completions[0].Options[0]
+	assert.Equal(t, "iPhone 15", option.Text)
+	assert.Equal(t, "1", option.ID)
+	assert.Equal(t, 2.0, option.Score)
+	assert.Equal(t, 999.0, option.Source.Price)
+	assert.Equal(t, []string{"apple"}, option.Contexts["brand"])
+}
+
+func Test_Suggest_should_return_error_for_missing_or_malformed_suggestion(t *testing.T) {
+	t.Parallel()
+	// Given
+	suggest := response.Suggest{"broken": []byte(`{"text": "x"}`)}
+
+	// When
+	_, missingErr := suggest.Term("missing")
+	_, malformedErr := suggest.Phrase("broken")
+
+	// Then
+	assert.True(t, errors.Is(missingErr, response.ErrSuggestionNotFound))
+	assert.Equal(t, "suggestion not found: \"missing\"", missingErr.Error())
+	assert.NotNil(t, malformedErr)
+}
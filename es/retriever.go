@@ -0,0 +1,246 @@
+package es
+
+type retrieverType Object
+
+// StandardRetriever creates a "standard" retriever, which returns the top
+// documents of a query like a search request without retrievers does.
+//
+// Example usage:
+//
+//	r := es.StandardRetriever(es.Match("title", "running shoes")).
+//		Filter(es.Term("brand", "acme")).
+//		MinScore(1.5)
+//	// r now contains {"standard": {"filter": [...], "min_score": 1.5, "query": {"match": {...}}}}
+//
+// Parameters:
+//   - queryClause: The query clause, such as es.Match or es.Bool results. Nil leaves the query out.
+//
+// Returns:
+//
+//	An es.retrieverType object for the "standard" retriever.
+func StandardRetriever(queryClause any) retrieverType {
+	standard := Object{}
+	if field, ok := correctType(queryClause); ok {
+		standard["query"] = field
+	}
+	return retrieverType{
+		"standard": standard,
+	}
+}
+
+// KnnRetriever creates a "knn" retriever from a copy of a knn search, so
+// changing the retriever does not change the search. The "k" and
+// "num_candidates" of the search are required by this retriever.
+//
+// Example usage:
+//
+//	r := es.KnnRetriever(es.Knn("title_embedding").QueryVector(vector...).K(10).NumCandidates(100))
+//	// r now contains {"knn": {"field": "title_embedding", "k": 10, "num_candidates": 100, "query_vector": [...]}}
+//
+// Parameters:
+//   - knn: An es.knnType created with es.Knn.
+//
+// Returns:
+//
+//	An es.retrieverType object for the "knn" retriever.
+func KnnRetriever(knn knnType) retrieverType {
+	return retrieverType{
+		"knn": knn.search(),
+	}
+}
+
+// RRFRetriever creates an "rrf" retriever, which merges the results of its
+// child retrievers with reciprocal rank fusion.
+//
+// Example usage:
+//
+//	r := es.RRFRetriever(
+//		es.StandardRetriever(es.Match("title", "running shoes")),
+//		es.KnnRetriever(es.Knn("title_embedding").QueryVector(vector...).K(10).NumCandidates(100)),
+//	).RankWindowSize(50).RankConstant(20)
+//
+// Parameters:
+//   - retrievers: The child retrievers, at least two.
+//
+// Returns:
+//
+//	An es.retrieverType object for the "rrf" retriever.
+func RRFRetriever(retrievers ...retrieverType) retrieverType {
+	return retrieverType{
+		"rrf": Object{
+			"retrievers": retrievers,
+		},
+	}
+}
+
+// RuleRetriever creates a "rule" retriever, which applies the query rules of
+// the given rulesets, such as pinned or excluded documents, to the results of
+// its child retriever.
+//
+// Example usage:
+//
+//	r := es.RuleRetriever(es.StandardRetriever(es.Match("title", "phone")), "promotions").
+//		MatchCriteria("user_query", "phone")
+//
+// Parameters:
+//   - retriever: The child retriever whose results the rules are applied to.
+//   - rulesetIDs: The ids of the query rulesets.
+//
+// Returns:
+//
+//	An es.retrieverType object for the "rule" retriever.
+func RuleRetriever(retriever retrieverType, rulesetIDs ...string) retrieverType {
+	return retrieverType{
+		"rule": Object{
+			"retriever":   retriever,
+			"ruleset_ids": rulesetIDs,
+		},
+	}
+}
+
+// TextSimilarityReranker creates a "text_similarity_reranker" retriever, which
+// reorders the top results of its child retriever with a text similarity
+// model served by the inference API.
+//
+// Example usage:
+//
+//	r := es.TextSimilarityReranker(es.StandardRetriever(es.Match("title", text)), "title", "rerank-model", text).
+//		RankWindowSize(100)
+//
+// Parameters:
+//   - retriever: The child retriever whose results are reranked.
+//   - field: The document field the inference text is compared with.
+//   - inferenceID: The id of the rerank inference endpoint.
+//   - inferenceText: The text the documents are compared with.
+//
+// Returns:
+//
+//	An es.retrieverType object for the "text_similarity_reranker" retriever.
+func TextSimilarityReranker(retriever retrieverType, field, inferenceID, inferenceText string) retrieverType {
+	return retrieverType{
+		"text_similarity_reranker": Object{
+			"retriever":      retriever,
+			"field":          field,
+			"inference_id":   inferenceID,
+			"inference_text": inferenceText,
+		},
+	}
+}
+
+// Filter adds queries that the documents of the retriever must match. Filters of
+// compound retrievers are applied to all of their child retrievers.
+//
+// Parameters:
+//   - filters: The query clauses, such as es.Term or es.Bool results. Nil values are skipped.
+//
+// Returns:
+//
+//	The updated es.retrieverType object with the queries added to "filter".
+func (r retrieverType) Filter(filters ...any) retrieverType {
+	for _, value := range r {
+		body, ok := value.(Object)
+		if !ok {
+			continue
+		}
+		filter, ok := body["filter"].(Array)
+		if !ok {
+			filter = make(Array, 0, len(filters))
+		}
+		for i := 0; i < len(filters); i++ {
+			if field, fOk := correctType(filters[i]); fOk {
+				filter = append(filter, field)
+			}
+		}
+		body["filter"] = filter
+		break
+	}
+	return r
+}
+
+// Sort sets the sort of a "standard" retriever.
+//
+// Parameters:
+//   - sorts: The es.sortType values created with es.Sort.
+//
+// Returns:
+//
+//	The updated es.retrieverType object with the "sort" field set.
+func (r retrieverType) Sort(sorts ...sortType) retrieverType {
+	return genericPutInTheFieldOfFirstObject(r, "sort", sorts)
+}
+
+// MinScore sets the minimum score documents of the retriever need to be
+// returned. It applies to "standard" and "text_similarity_reranker" retrievers.
+//
+// Parameters:
+//   - minScore: The minimum score.
+//
+// Returns:
+//
+//	The updated es.retrieverType object with the "min_score" field set.
+func (r retrieverType) MinScore(minScore float64) retrieverType {
+	return genericPutInTheFieldOfFirstObject(r, "min_score", minScore)
+}
+
+// Collapse collapses the results of a "standard" retriever on a field.
+//
+// Parameters:
+//   - fieldCollapse: An es.fieldCollapseType created with es.FieldCollapse.
+//
+// Returns:
+//
+//	The updated es.retrieverType object with the "collapse" field set.
+func (r retrieverType) Collapse(fieldCollapse fieldCollapseType) retrieverType {
+	return genericPutInTheFieldOfFirstObject(r, "collapse", fieldCollapse)
+}
+
+// RankWindowSize sets how many top documents of each child retriever are
+// considered by "rrf", "rule" and "text_similarity_reranker" retrievers.
+//
+// Parameters:
+//   - rankWindowSize: The number of documents.
+//
+// Returns:
+//
+//	The updated es.retrieverType object with the "rank_window_size" field set.
+func (r retrieverType) RankWindowSize(rankWindowSize int) retrieverType {
+	return genericPutInTheFieldOfFirstObject(r, "rank_window_size", rankWindowSize)
+}
+
+// RankConstant sets the constant of the reciprocal rank fusion formula of an
+// "rrf" retriever. Higher values give documents ranked lower more influence.
+//
+// Parameters:
+//   - rankConstant: The rank constant, at least 1.
+//
+// Returns:
+//
+//	The updated es.retrieverType object with the "rank_constant" field set.
+func (r retrieverType) RankConstant(rankConstant int) retrieverType {
+	return genericPutInTheFieldOfFirstObject(r, "rank_constant", rankConstant)
+}
+
+// MatchCriteria adds a value that the query rules of a "rule" retriever are
+// matched against.
+//
+// Parameters:
+//   - key: The criteria key used in the rules, e.g. "user_query".
+//   - value: The value of the criteria.
+//
+// Returns:
+//
+//	The updated es.retrieverType object with the value added to "match_criteria".
+func (r retrieverType) MatchCriteria(key string, value any) retrieverType {
+	for _, entry := range r {
+		if body, ok := entry.(Object); ok {
+			criteria, exists := body["match_criteria"].(Object)
+			if !exists {
+				criteria = Object{}
+				body["match_criteria"] = criteria
+			}
+			criteria[key] = value
+			break
+		}
+	}
+	return r
+}
@@ -0,0 +1,178 @@
+package es_test
+
+import (
+	"testing"
+
+	Order "github.com/Trendyol/es-query-builder/es/enums/sort/order"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Retriever   ////
+
+func Test_Retrievers_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.StandardRetriever)
+	assert.NotNil(t, es.KnnRetriever)
+	assert.NotNil(t, es.RRFRetriever)
+	assert.NotNil(t, es.RuleRetriever)
+	assert.NotNil(t, es.TextSimilarityReranker)
+}
+
+func Test_StandardRetriever_should_create_retrieverType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	retriever := es.StandardRetriever(nil)
+
+	// Then
+	assert.IsTypeString(t, "es.retrieverType", retriever)
+	bodyJSON := assert.MarshalWithoutError(t, retriever)
+	assert.Equal(t, "{\"standard\":{}}", bodyJSON)
+}
+
+func Test_StandardRetriever_should_create_json_with_all_parameters(t *testing.T) {
+	t.Parallel()
+	// Given
+	retriever := es.StandardRetriever(es.Bool().Must(es.Match("title", "shoes"))).
+		Filter(es.Term("brand", "acme"), nil).
+		Sort(es.Sort("price").Order(Order.Asc)).
+		MinScore(1.5).
+		Collapse(es.FieldCollapse("seller_id"))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, retriever)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"standard\":{\"collapse\":{\"field\":\"seller_id\"},\"filter\":[{\"term\":{\"brand\":{\"value\":\"acme\"}}}],\"min_score\":1.5,\"query\":{\"bool\":{\"must\":[{\"match\":{\"title\":{\"query\":\"shoes\"}}}]}},\"sort\":[{\"price\":{\"order\":\"asc\"}}]}}", bodyJSON)
+}
+
+func Test_KnnRetriever_should_reuse_knn_search(t *testing.T) {
+	t.Parallel()
+	// Given
+	retriever := es.KnnRetriever(es.Knn("title_embedding").QueryVector(1, 2).K(10).NumCandidates(100)).
+		Filter(es.Term("brand", "acme"))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, retriever)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"knn\":{\"field\":\"title_embedding\",\"filter\":[{\"term\":{\"brand\":{\"value\":\"acme\"}}}],\"k\":10,\"num_candidates\":100,\"query_vector\":[1,2]}}", bodyJSON)
+}
+
+func Test_KnnRetriever_should_not_change_knn_search(t *testing.T) {
+	t.Parallel()
+	// Given
+	knn := es.Knn("title_embedding").QueryVector(1, 2).Filter(es.Term("brand", "acme"))
+
+	// When
+	es.KnnRetriever(knn).Filter(es.Term("color", "red"))
+	es.KnnRetriever(knn).Filter(es.Term("size", 42))
+
+	// Then
+	bodyJSON := assert.MarshalWithoutError(t, knn)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"knn\":{\"field\":\"title_embedding\",\"filter\":[{\"term\":{\"brand\":{\"value\":\"acme\"}}}],\"query_vector\":[1,2]}}", bodyJSON)
+}
+
+func Test_RRFRetriever_should_create_json_with_nested_retrievers(t *testing.T) {
+	t.Parallel()
+	// Given
+	retriever := es.RRFRetriever(
+		es.StandardRetriever(es.Match("title", "shoes")),
+		es.KnnRetriever(es.Knn("title_embedding").QueryVector(1).K(5).NumCandidates(50)),
+	).RankWindowSize(50).RankConstant(20)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, retriever)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"rrf\":{\"rank_constant\":20,\"rank_window_size\":50,\"retrievers\":[{\"standard\":{\"query\":{\"match\":{\"title\":{\"query\":\"shoes\"}}}}},{\"knn\":{\"field\":\"title_embedding\",\"k\":5,\"num_candidates\":50,\"query_vector\":[1]}}]}}", bodyJSON)
+}
+
+func Test_RuleRetriever_should_create_json_with_match_criteria(t *testing.T) {
+	t.Parallel()
+	// Given
+	retriever := es.RuleRetriever(es.StandardRetriever(es.MatchAll()), "promotions", "seasonal").
+		MatchCriteria("user_query", "phone").
+		MatchCriteria("country", "tr").
+		RankWindowSize(20)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, retriever)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"rule\":{\"match_criteria\":{\"country\":\"tr\",\"user_query\":\"phone\"},\"rank_window_size\":20,\"retriever\":{\"standard\":{\"query\":{\"match_all\":{}}}},\"ruleset_ids\":[\"promotions\",\"seasonal\"]}}", bodyJSON)
+}
+
+func Test_TextSimilarityReranker_should_create_json(t *testing.T) {
+	t.Parallel()
+	// Given
+	retriever := es.TextSimilarityReranker(es.StandardRetriever(es.Match("title", "shoes")), "title", "rerank-model", "shoes").
+		RankWindowSize(100).
+		MinScore(0.5)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, retriever)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"text_similarity_reranker\":{\"field\":\"title\",\"inference_id\":\"rerank-model\",\"inference_text\":\"shoes\",\"min_score\":0.5,\"rank_window_size\":100,\"retriever\":{\"standard\":{\"query\":{\"match\":{\"title\":{\"query\":\"shoes\"}}}}}}}", bodyJSON)
+}
+
+func Test_Object_Retriever_should_replace_empty_query(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(nil).Size(10).Retriever(es.StandardRetriever(es.MatchAll()))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	assert.Equal(t, "{\"retriever\":{\"standard\":{\"query\":{\"match_all\":{}}}},\"size\":10}", bodyJSON)
+	assert.Equal(t, 0, len(es.Validate(query)))
+}
+
+func Test_Validate_should_report_retriever_combined_with_query_or_knn(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.MatchAll()).
+		Knn(es.Knn("title_embedding").QueryVector(1).K(5)).
+		Retriever(es.StandardRetriever(nil))
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"retriever: cannot be combined with \"knn\"",
+		"retriever: cannot be combined with \"query\"",
+	}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_mistakes_inside_retriever_tree(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.Object{}.Retriever(es.RRFRetriever(
+		es.StandardRetriever(es.Range("price")).Filter(es.Terms[string]("brand")),
+		es.RuleRetriever(es.RRFRetriever(), "promotions"),
+	))
+	rawQuery := es.Object{"retriever": es.Object{"text_similarity_reranker": es.Object{}}}
+	knnQuery := es.Object{}.Retriever(es.RRFRetriever(
+		es.KnnRetriever(es.Knn("title_embedding").QueryVector(1)),
+		es.KnnRetriever(es.Knn("").K(20).NumCandidates(10)),
+	))
+
+	// When
+	errs := es.Validate(query)
+	rawErrs := es.Validate(rawQuery)
+	knnErrs := es.Validate(knnQuery)
+
+	// Then
+	assert.Equal(t, []string{
+		"retriever.rrf.retrievers[0].standard.filter[0].terms.brand: no values",
+		"retriever.rrf.retrievers[0].standard.query.range.price: no bounds",
+		"retriever.rrf.retrievers[1].rule.retriever.rrf: missing retrievers",
+	}, validationMessages(errs))
+	assert.Equal(t, []string{"retriever.text_similarity_reranker: missing retriever"}, validationMessages(rawErrs))
+	assert.Equal(t, []string{
+		"retriever.rrf.retrievers[0].knn: missing k",
+		"retriever.rrf.retrievers[0].knn: missing num_candidates",
+		"retriever.rrf.retrievers[1].knn: empty field",
+		"retriever.rrf.retrievers[1].knn: missing query_vector or query_vector_builder",
+		"retriever.rrf.retrievers[1].knn: k is greater than num_candidates",
+	}, validationMessages(knnErrs))
+}
@@ -0,0 +1,136 @@
+package es
+
+import (
+	RuntimeFieldType "github.com/Trendyol/es-query-builder/es/enums/runtime-field-type"
+)
+
+// RuntimeMappingType is a named runtime field built with es.RuntimeField. It is
+// exported so the index mappings of es/mapping accept the same builder.
+type RuntimeMappingType Object
+
+// RuntimeField creates a named runtime field for the "runtime_mappings" of a
+// search request. Its values are computed at query time, so queries, sorts and
+// aggregations can use it as if it were indexed.
+//
+// Example usage:
+//
+//	margin := es.RuntimeField("margin", RuntimeFieldType.Double).
+//		Script(es.ScriptSource("emit(doc['price'].value - doc['cost'].value)", ScriptLanguage.Painless))
+//	// margin now contains {"margin": {"script": {...}, "type": "double"}}
+//
+// Parameters:
+//   - name: The name of the runtime field.
+//   - fieldType: A RuntimeFieldType.RuntimeFieldType value.
+//
+// Returns:
+//
+//	An es.RuntimeMappingType object.
+func RuntimeField(name string, fieldType RuntimeFieldType.RuntimeFieldType) RuntimeMappingType {
+	return RuntimeMappingType{
+		name: Object{
+			"type": fieldType,
+		},
+	}
+}
+
+// Script sets the script that emits the values of the runtime field. Without a
+// script, the value is read from _source.
+//
+// Parameters:
+//   - script: An es.scriptType created with es.ScriptSource or es.ScriptID.
+//
+// Returns:
+//
+//	The updated es.RuntimeMappingType object with the "script" field set.
+func (rf RuntimeMappingType) Script(script scriptType) RuntimeMappingType {
+	return genericPutInTheFieldOfFirstObject(rf, "script", script)
+}
+
+// Format sets the format of a date runtime field.
+//
+// Parameters:
+//   - format: A date format, such as "yyyy-MM-dd".
+//
+// Returns:
+//
+//	The updated es.RuntimeMappingType object with the "format" field set.
+func (rf RuntimeMappingType) Format(format string) RuntimeMappingType {
+	return genericPutInTheFieldOfFirstObject(rf, "format", format)
+}
+
+// Fields sets the fields emitted by the script of a composite runtime field.
+// Each field is referenced as "<name>.<field>" in the search request.
+//
+// Example usage:
+//
+//	prices := es.RuntimeField("prices", RuntimeFieldType.Composite).
+//		Script(script).
+//		Fields(es.RuntimeField("margin", RuntimeFieldType.Double), es.RuntimeField("discount", RuntimeFieldType.Double))
+//
+// Parameters:
+//   - fields: The subfields, created with es.RuntimeField.
+//
+// Returns:
+//
+//	The updated es.RuntimeMappingType object with the "fields" field set.
+func (rf RuntimeMappingType) Fields(fields ...RuntimeMappingType) RuntimeMappingType {
+	return genericPutInTheFieldOfFirstObject(rf, "fields", mergeRuntimeFields(Object{}, fields))
+}
+
+// TargetIndex sets the index a lookup runtime field retrieves values from.
+//
+// Parameters:
+//   - index: The name of the lookup index.
+//
+// Returns:
+//
+//	The updated es.RuntimeMappingType object with the "target_index" field set.
+func (rf RuntimeMappingType) TargetIndex(index string) RuntimeMappingType {
+	return genericPutInTheFieldOfFirstObject(rf, "target_index", index)
+}
+
+// InputField sets the field of the document whose value is looked up.
+//
+// Parameters:
+//   - field: The name of the input field.
+//
+// Returns:
+//
+//	The updated es.RuntimeMappingType object with the "input_field" field set.
+func (rf RuntimeMappingType) InputField(field string) RuntimeMappingType {
+	return genericPutInTheFieldOfFirstObject(rf, "input_field", field)
+}
+
+// TargetField sets the field of the lookup index matched against the input field.
+//
+// Parameters:
+//   - field: The name of the target field.
+//
+// Returns:
+//
+//	The updated es.RuntimeMappingType object with the "target_field" field set.
+func (rf RuntimeMappingType) TargetField(field string) RuntimeMappingType {
+	return genericPutInTheFieldOfFirstObject(rf, "target_field", field)
+}
+
+// FetchFields sets the fields of the lookup index returned by a lookup runtime field.
+//
+// Parameters:
+//   - fields: The names of the fields to fetch.
+//
+// Returns:
+//
+//	The updated es.RuntimeMappingType object with the "fetch_fields" field set.
+func (rf RuntimeMappingType) FetchFields(fields ...string) RuntimeMappingType {
+	return genericPutInTheFieldOfFirstObject(rf, "fetch_fields", fields)
+}
+
+// mergeRuntimeFields puts the single named entry of each runtime field into target.
+func mergeRuntimeFields(target Object, fields []RuntimeMappingType) Object {
+	for _, field := range fields {
+		for name, definition := range field {
+			target[name] = definition
+		}
+	}
+	return target
+}
@@ -0,0 +1,92 @@
+package es_test
+
+import (
+	"testing"
+
+	RuntimeFieldType "github.com/Trendyol/es-query-builder/es/enums/runtime-field-type"
+	ScriptLanguage "github.com/Trendyol/es-query-builder/es/enums/script-language"
+	Order "github.com/Trendyol/es-query-builder/es/enums/sort/order"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   RuntimeMappings   ////
+
+func Test_RuntimeField_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.RuntimeField)
+}
+
+func Test_RuntimeField_should_create_RuntimeMappingType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	field := es.RuntimeField("margin", RuntimeFieldType.Double)
+
+	// Then
+	assert.IsTypeString(t, "es.RuntimeMappingType", field)
+	bodyJSON := assert.MarshalWithoutError(t, field)
+	assert.Equal(t, "{\"margin\":{\"type\":\"double\"}}", bodyJSON)
+}
+
+func Test_RuntimeField_should_create_json_with_script_and_format(t *testing.T) {
+	t.Parallel()
+	// Given
+	field := es.RuntimeField("listed_day", RuntimeFieldType.Date).
+		Script(es.ScriptSource("emit(doc['listed_at'].value.toInstant().toEpochMilli())", ScriptLanguage.Painless)).
+		Format("yyyy-MM-dd")
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, field)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"listed_day\":{\"format\":\"yyyy-MM-dd\",\"script\":{\"lang\":\"painless\",\"source\":\"emit(doc['listed_at'].value.toInstant().toEpochMilli())\"},\"type\":\"date\"}}", bodyJSON)
+}
+
+func Test_RuntimeField_should_create_json_with_composite_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	field := es.RuntimeField("prices", RuntimeFieldType.Composite).
+		Script(es.ScriptID("price-parts", ScriptLanguage.Painless)).
+		Fields(
+			es.RuntimeField("margin", RuntimeFieldType.Double),
+			es.RuntimeField("on_sale", RuntimeFieldType.Boolean),
+		)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, field)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"prices\":{\"fields\":{\"margin\":{\"type\":\"double\"},\"on_sale\":{\"type\":\"boolean\"}},\"script\":{\"id\":\"price-parts\",\"lang\":\"painless\"},\"type\":\"composite\"}}", bodyJSON)
+}
+
+func Test_RuntimeField_should_create_json_with_lookup_parameters(t *testing.T) {
+	t.Parallel()
+	// Given
+	field := es.RuntimeField("seller", RuntimeFieldType.Lookup).
+		TargetIndex("sellers").
+		InputField("seller_id").
+		TargetField("id").
+		FetchFields("name", "rating")
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, field)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"seller\":{\"fetch_fields\":[\"name\",\"rating\"],\"input_field\":\"seller_id\",\"target_field\":\"id\",\"target_index\":\"sellers\",\"type\":\"lookup\"}}", bodyJSON)
+}
+
+func Test_RuntimeMappings_should_add_runtime_mappings_into_Object(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Range("margin").GreaterThan(10)).
+		RuntimeMappings(
+			es.RuntimeField("margin", RuntimeFieldType.Double).
+				Script(es.ScriptSource("emit(doc['price'].value - doc['cost'].value)", ScriptLanguage.Painless)),
+		).
+		RuntimeMappings(es.RuntimeField("seller_ip", RuntimeFieldType.IP)).
+		Sort(es.Sort("margin").Order(Order.Desc))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"range\":{\"margin\":{\"gt\":10}}},\"runtime_mappings\":{\"margin\":{\"script\":{\"lang\":\"painless\",\"source\":\"emit(doc['price'].value - doc['cost'].value)\"},\"type\":\"double\"},\"seller_ip\":{\"type\":\"ip\"}},\"sort\":[{\"margin\":{\"order\":\"desc\"}}]}", bodyJSON)
+}
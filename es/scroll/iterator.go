@@ -0,0 +1,210 @@
+// Package scroll reads every hit of a search with the scroll API, for batch
+// exports that need a consistent snapshot of the results. For paging through
+// results that users browse, prefer es/pit.
+package scroll
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/es/transport"
+)
+
+// DefaultKeepAlive is how long the scroll context is kept alive between pages
+// unless Iterator.KeepAlive sets another value.
+const DefaultKeepAlive = "1m"
+
+// Iterator reads the hits of a search page by page with the scroll API. The
+// scroll context is cleared once every hit has been read, when a request fails
+// or when Close is called, whichever comes first.
+type Iterator[T any] struct {
+	do        transport.Func
+	query     es.Object
+	slice     es.Object
+	index     string
+	keepAlive string
+	scrollID  string
+	done      bool
+}
+
+// New creates an iterator over the hits of query in the given indices.
+//
+// Example usage:
+//
+//	it := scroll.New[Product](transport.HTTP(http.DefaultClient, url), "products", es.NewQuery(es.MatchAll()).Size(1000))
+//	defer it.Close(ctx)
+//	for {
+//		page, err := it.Next(ctx)
+//		if errors.Is(err, io.EOF) {
+//			break
+//		}
+//		if err != nil {
+//			return err
+//		}
+//		// export page.Hits.Hits
+//	}
+//
+// Parameters:
+//   - do: The transport.Func used to search, scroll and clear the scroll.
+//   - index: The indices to search, separated by commas.
+//   - query: The search body. Its "size" sets the page size.
+//
+// Returns:
+//
+//	A pointer to a scroll.Iterator that starts the scroll on the first call to Next.
+func New[T any](do transport.Func, index string, query es.Object) *Iterator[T] {
+	return &Iterator[T]{
+		do:        do,
+		query:     query,
+		index:     index,
+		keepAlive: DefaultKeepAlive,
+	}
+}
+
+// KeepAlive sets how long the scroll context is kept alive between pages.
+//
+// Parameters:
+//   - keepAlive: A time value such as "5m".
+//
+// Returns:
+//
+//	The scroll.Iterator, for chaining.
+func (it *Iterator[T]) KeepAlive(keepAlive string) *Iterator[T] {
+	it.keepAlive = keepAlive
+	return it
+}
+
+// Slice makes the iterator read a single slice of the hits, so several workers
+// can export the same search in parallel, each with its own iterator.
+//
+// Example usage:
+//
+//	for id := 0; id < workers; id++ {
+//		go export(scroll.New[Product](do, "products", query).Slice(id, workers))
+//	}
+//
+// Parameters:
+//   - id: The zero based id of the slice.
+//   - maxSlices: The total number of slices.
+//
+// Returns:
+//
+//	The scroll.Iterator, for chaining.
+func (it *Iterator[T]) Slice(id, maxSlices int) *Iterator[T] {
+	it.slice = es.Object{
+		"id":  id,
+		"max": maxSlices,
+	}
+	return it
+}
+
+// ScrollID returns the id of the scroll context, or an empty string when it is
+// not started or already cleared.
+func (it *Iterator[T]) ScrollID() string {
+	return it.scrollID
+}
+
+// Next fetches the next page, starting the scroll on the first call.
+//
+// Parameters:
+//   - ctx: The context of the requests.
+//
+// Returns:
+//
+//	The next page with at least one hit, io.EOF when every hit has been read,
+//	or the error of the transport. The scroll context is cleared before io.EOF
+//	or an error is returned.
+func (it *Iterator[T]) Next(ctx context.Context) (*response.SearchResponse[T], error) {
+	if it.done {
+		return nil, io.EOF
+	}
+	page, err := it.fetch(ctx)
+	if err != nil {
+		_ = it.Close(ctx)
+		return nil, err
+	}
+	if len(page.Hits.Hits) == 0 {
+		if err = it.Close(ctx); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return page, nil
+}
+
+// Close clears the scroll context and stops the iterator. It does nothing when
+// no scroll context is open.
+//
+// Parameters:
+//   - ctx: The context of the request.
+//
+// Returns:
+//
+//	The error of the transport, if any.
+func (it *Iterator[T]) Close(ctx context.Context) error {
+	it.done = true
+	if it.scrollID == "" {
+		return nil
+	}
+	body, err := json.Marshal(es.Object{"scroll_id": []string{it.scrollID}})
+	if err != nil {
+		return err
+	}
+	it.scrollID = ""
+	_, err = it.do(ctx, http.MethodDelete, "/_search/scroll", body)
+	return err
+}
+
+func (it *Iterator[T]) fetch(ctx context.Context) (*response.SearchResponse[T], error) {
+	var path string
+	var request es.Object
+	if it.scrollID == "" {
+		path = "/" + escapeIndex(it.index) + "/_search?scroll=" + url.QueryEscape(it.keepAlive)
+		request = make(es.Object, len(it.query)+1)
+		for key, value := range it.query {
+			request[key] = value
+		}
+		if it.slice != nil {
+			request["slice"] = it.slice
+		}
+	} else {
+		path = "/_search/scroll"
+		request = es.Object{
+			"scroll":    it.keepAlive,
+			"scroll_id": it.scrollID,
+		}
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	data, err := it.do(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return nil, err
+	}
+	// The scroll id is read before the hits, so the scroll can still be
+	// cleared when the documents do not decode into T.
+	var scrollID struct {
+		ID string `json:"_scroll_id"`
+	}
+	if json.Unmarshal(data, &scrollID) == nil && scrollID.ID != "" {
+		it.scrollID = scrollID.ID
+	}
+	return response.Unmarshal[T](data)
+}
+
+// escapeIndex escapes each name of a comma-separated index list for a URL path,
+// keeping the commas that separate them.
+func escapeIndex(index string) string {
+	names := strings.Split(index, ",")
+	for i := 0; i < len(names); i++ {
+		names[i] = url.PathEscape(names[i])
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,252 @@
+package scroll_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/es/scroll"
+	"github.com/Trendyol/es-query-builder/es/transport"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+type product struct {
+	Name string `json:"name"`
+}
+
+// cluster is a local stand-in for the scroll endpoints. Scroll ids hold the
+// position of the next hit, and sliced searches only see the names whose
+// position modulo max equals the slice id.
+type cluster struct {
+	cleared  []string
+	requests []string
+	bodies   []string
+	names    []string
+	mu       sync.Mutex
+	failAt   int
+}
+
+func (c *cluster) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, _ := io.ReadAll(r.Body)
+	c.requests = append(c.requests, r.Method+" "+r.URL.RequestURI())
+	c.bodies = append(c.bodies, string(data))
+	if c.failAt > 0 && len(c.requests) == c.failAt {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"error":{"type":"exception","reason":"boom"},"status":500}`))
+		return
+	}
+	var body struct {
+		Slice    *struct{ ID, Max int } `json:"slice"`
+		Size     *int                   `json:"size"`
+		ScrollID any                    `json:"scroll_id"`
+	}
+	_ = json.Unmarshal(data, &body)
+	if r.Method == http.MethodDelete {
+		c.cleared = append(c.cleared, fmt.Sprint(body.ScrollID))
+		_, _ = w.Write([]byte(`{"succeeded":true,"num_freed":1}`))
+		return
+	}
+	position, size, sliceID, sliceMax := 0, 2, 0, 1
+	if id, ok := body.ScrollID.(string); ok {
+		_, _ = fmt.Sscanf(id, "%d/%d/%d", &position, &sliceID, &sliceMax)
+	}
+	if body.Slice != nil {
+		sliceID, sliceMax = body.Slice.ID, body.Slice.Max
+	}
+	if body.Size != nil {
+		size = *body.Size
+	}
+	hits := make([]string, 0, size)
+	for ; position < len(c.names) && len(hits) < size; position++ {
+		if position%sliceMax == sliceID {
+			hits = append(hits, `{"_id":"`+strconv.Itoa(position)+`","_source":{"name":"`+c.names[position]+`"}}`)
+		}
+	}
+	_, _ = fmt.Fprintf(w, `{"_scroll_id":"%d/%d/%d","hits":{"hits":[%s]}}`, position, sliceID, sliceMax, strings.Join(hits, ","))
+}
+
+func readAll(t *testing.T, it *scroll.Iterator[product]) ([]string, error) {
+	t.Helper()
+	var names []string
+	for {
+		page, err := it.Next(context.Background())
+		if errors.Is(err, io.EOF) {
+			return names, nil
+		}
+		if err != nil {
+			return names, err
+		}
+		for _, hit := range page.Hits.Hits {
+			names = append(names, hit.Source.Name)
+		}
+	}
+}
+
+////   Iterator   ////
+
+func Test_Iterator_should_read_every_page_and_clear_scroll(t *testing.T) {
+	t.Parallel()
+	// Given
+	stub := &cluster{names: []string{"a", "b", "c", "d", "e"}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	it := scroll.New[product](transport.HTTP(server.Client(), server.URL), "products", es.NewQuery(es.MatchAll()).Size(2)).
+		KeepAlive("5m")
+
+	// When
+	names, err := readAll(t, it)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+	assert.Equal(t, []string{
+		"POST /products/_search?scroll=5m",
+		"POST /_search/scroll",
+		"POST /_search/scroll",
+		"POST /_search/scroll",
+		"DELETE /_search/scroll",
+	}, stub.requests)
+	assert.Equal(t, "{\"query\":{\"match_all\":{}},\"size\":2}", stub.bodies[0])
+	assert.Equal(t, "{\"scroll\":\"5m\",\"scroll_id\":\"2/0/1\"}", stub.bodies[1])
+	assert.Equal(t, []string{"[5/0/1]"}, stub.cleared)
+	assert.Equal(t, "", it.ScrollID())
+}
+
+func Test_Iterator_Slice_should_split_hits_between_workers(t *testing.T) {
+	t.Parallel()
+	// Given
+	stub := &cluster{names: []string{"a", "b", "c", "d", "e"}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	do := transport.HTTP(server.Client(), server.URL)
+	query := es.NewQuery(es.MatchAll())
+
+	// When
+	first, firstErr := readAll(t, scroll.New[product](do, "products", query).Slice(0, 2))
+	second, secondErr := readAll(t, scroll.New[product](do, "products", query).Slice(1, 2))
+
+	// Then
+	assert.Nil(t, firstErr)
+	assert.Nil(t, secondErr)
+	assert.Equal(t, []string{"a", "c", "e"}, first)
+	assert.Equal(t, []string{"b", "d"}, second)
+	assert.Equal(t, "{\"query\":{\"match_all\":{}},\"slice\":{\"id\":0,\"max\":2}}", stub.bodies[0])
+	assert.Equal(t, "{\"query\":{\"match_all\":{}}}", assert.MarshalWithoutError(t, query))
+	assert.Equal(t, 2, len(stub.cleared))
+}
+
+func Test_Iterator_should_clear_scroll_when_a_page_fails(t *testing.T) {
+	t.Parallel()
+	// Given
+	stub := &cluster{names: []string{"a", "b", "c", "d", "e"}, failAt: 2}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	it := scroll.New[product](transport.HTTP(server.Client(), server.URL), "products", es.NewQuery(nil))
+
+	// When
+	names, err := readAll(t, it)
+	_, again := it.Next(context.Background())
+
+	// Then
+	assert.Equal(t, []string{"a", "b"}, names)
+	assert.Equal(t, "500 exception: boom", err.Error())
+	assert.Equal(t, "DELETE /_search/scroll", stub.requests[2])
+	assert.Equal(t, []string{"[2/0/1]"}, stub.cleared)
+	assert.True(t, errors.Is(again, io.EOF))
+}
+
+func Test_Iterator_Close_should_clear_scroll_before_the_last_page(t *testing.T) {
+	t.Parallel()
+	// Given
+	stub := &cluster{names: []string{"a", "b", "c"}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	it := scroll.New[product](transport.HTTP(server.Client(), server.URL), "products", es.NewQuery(nil))
+	page, err := it.Next(context.Background())
+	assert.Nil(t, err)
+
+	// When
+	closeErr := it.Close(context.Background())
+	secondCloseErr := it.Close(context.Background())
+	_, nextErr := it.Next(context.Background())
+
+	// Then
+	assert.Equal(t, 2, len(page.Hits.Hits))
+	assert.Nil(t, closeErr)
+	assert.Nil(t, secondCloseErr)
+	assert.True(t, errors.Is(nextErr, io.EOF))
+	assert.Equal(t, []string{"[2/0/1]"}, stub.cleared)
+}
+
+func Test_Iterator_should_return_transport_and_decode_errors(t *testing.T) {
+	t.Parallel()
+	// Given
+	var requests []string
+	failing := func(_ context.Context, method, path string, _ []byte) ([]byte, error) {
+		requests = append(requests, method+" "+path)
+		return nil, fmt.Errorf("%s %s failed", method, path)
+	}
+	invalid := func(_ context.Context, _, _ string, _ []byte) ([]byte, error) {
+		return []byte(`{"hits":`), nil
+	}
+
+	// When
+	_, searchErr := scroll.New[product](failing, "products", es.NewQuery(nil)).Next(context.Background())
+	_, decodeErr := scroll.New[product](invalid, "products", es.NewQuery(nil)).Next(context.Background())
+
+	// Then
+	assert.Equal(t, "POST /products/_search?scroll=1m failed", searchErr.Error())
+	assert.Equal(t, []string{"POST /products/_search?scroll=1m"}, requests)
+	assert.NotNil(t, decodeErr)
+}
+
+func Test_Iterator_should_keep_commas_of_index_list_in_path(t *testing.T) {
+	t.Parallel()
+	// Given
+	var requests []string
+	failing := func(_ context.Context, method, path string, _ []byte) ([]byte, error) {
+		requests = append(requests, method+" "+path)
+		return nil, errors.New("unavailable")
+	}
+
+	// When
+	_, err := scroll.New[product](failing, "products,archive/2024", es.NewQuery(nil)).Next(context.Background())
+
+	// Then
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"POST /products,archive%2F2024/_search?scroll=1m"}, requests)
+}
+
+func Test_Iterator_should_clear_scroll_when_the_first_page_does_not_decode(t *testing.T) {
+	t.Parallel()
+	// Given
+	stub := &cluster{names: []string{"a", "b", "c"}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	type mismatched struct {
+		Name int `json:"name"`
+	}
+	it := scroll.New[mismatched](transport.HTTP(server.Client(), server.URL), "products", es.NewQuery(nil))
+
+	// When
+	_, err := it.Next(context.Background())
+	_, again := it.Next(context.Background())
+
+	// Then
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"POST /products/_search?scroll=1m", "DELETE /_search/scroll"}, stub.requests)
+	assert.Equal(t, []string{"[2/0/1]"}, stub.cleared)
+	assert.Equal(t, "", it.ScrollID())
+	assert.True(t, errors.Is(again, io.EOF))
+}
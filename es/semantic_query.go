@@ -0,0 +1,40 @@
+package es
+
+type semanticType Object
+
+// Semantic creates a new es.semanticType object for a semantic query on a
+// semantic_text field. The inference endpoint of the field turns the query text
+// into dense or sparse vectors, so no model details are needed in the query.
+//
+// Example usage:
+//
+//	s := es.Semantic("description_semantic", "shoes for trail running")
+//	// s now contains {"semantic": {"field": "description_semantic", "query": "shoes for trail running"}}
+//
+// Parameters:
+//   - field: The name of the semantic_text field.
+//   - query: The query text.
+//
+// Returns:
+//
+//	An es.semanticType object containing the semantic query.
+func Semantic(field, query string) semanticType {
+	return semanticType{
+		"semantic": Object{
+			"field": field,
+			"query": query,
+		},
+	}
+}
+
+// Boost sets the "boost" field in the semantic query.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.semanticType object with the "boost" field set.
+func (s semanticType) Boost(boost float64) semanticType {
+	return genericPutInTheField(s, "semantic", "boost", boost)
+}
@@ -0,0 +1,38 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Semantic   ////
+
+func Test_Semantic_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.Semantic)
+}
+
+func Test_Semantic_should_create_semanticType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	semantic := es.Semantic("description_semantic", "shoes for trail running")
+
+	// Then
+	assert.IsTypeString(t, "es.semanticType", semantic)
+	bodyJSON := assert.MarshalWithoutError(t, semantic)
+	assert.Equal(t, "{\"semantic\":{\"field\":\"description_semantic\",\"query\":\"shoes for trail running\"}}", bodyJSON)
+}
+
+func Test_Semantic_should_add_boost_and_plug_into_Bool_Must(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Must(es.Semantic("description_semantic", "trail shoes").Boost(1.5)))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"bool\":{\"must\":[{\"semantic\":{\"boost\":1.5,\"field\":\"description_semantic\",\"query\":\"trail shoes\"}}]}}}", bodyJSON)
+}
@@ -0,0 +1,333 @@
+package es
+
+type spanQueryType Object
+
+// spanNearType is a span_near query, the only span query with Slop and InOrder.
+type spanNearType Object
+
+// spanNotType is a span_not query, the only span query with Pre, Post and Dist.
+type spanNotType Object
+
+// spanClause is implemented by every span query builder, so compound span
+// queries accept any of them as a clause.
+type spanClause interface {
+	isSpanClause()
+}
+
+func (s spanQueryType) isSpanClause() {}
+
+func (s spanNearType) isSpanClause() {}
+
+func (s spanNotType) isSpanClause() {}
+
+// spanMultiTermQuery lists the query builders span_multi can wrap.
+type spanMultiTermQuery interface {
+	prefixType | wildcardType | regexpType | fuzzyType | rangeType
+}
+
+// SpanTerm creates a span_term query, which matches spans containing the term.
+//
+// Span queries are positional: they match the positions of terms in a field,
+// and compound span queries such as es.SpanNear only accept span queries as
+// their clauses.
+//
+// Example usage:
+//
+//	s := es.SpanTerm("body", "indemnify")
+//	// s now contains {"span_term": {"body": {"value": "indemnify"}}}
+//
+// Parameters:
+//   - field: The name of the field.
+//   - value: The term to match.
+//
+// Returns:
+//
+//	An es.spanQueryType object containing the span_term query.
+func SpanTerm(field, value string) spanQueryType {
+	return spanQueryType{
+		"span_term": Object{
+			field: Object{
+				"value": value,
+			},
+		},
+	}
+}
+
+// SpanMulti creates a span_multi query, which turns a prefix, wildcard, regexp,
+// fuzzy or range query into a span query so it can be used inside other span queries.
+//
+// Example usage:
+//
+//	s := es.SpanMulti(es.Prefix("body", "indemn"))
+//	// s now contains {"span_multi": {"match": {"prefix": {"body": {"value": "indemn"}}}}}
+//
+// Parameters:
+//   - query: An es.Prefix, es.Wildcard, es.Regexp, es.Fuzzy or es.Range query.
+//
+// Returns:
+//
+//	An es.spanQueryType object containing the span_multi query.
+func SpanMulti[T spanMultiTermQuery](query T) spanQueryType {
+	return spanQueryType{
+		"span_multi": Object{
+			"match": Object(query),
+		},
+	}
+}
+
+// SpanNear creates a span_near query, which matches spans of its clauses that
+// are near each other. The distance is set with Slop and the order with InOrder.
+//
+// Example usage:
+//
+//	s := es.SpanNear(es.SpanTerm("body", "breach"), es.SpanTerm("body", "contract")).
+//		Slop(3).
+//		InOrder(true)
+//	// s now contains {"span_near": {"clauses": [...], "in_order": true, "slop": 3}}
+//
+// Parameters:
+//   - clauses: The span queries that have to be near each other.
+//
+// Returns:
+//
+//	An es.spanNearType object containing the span_near query.
+func SpanNear(clauses ...spanClause) spanNearType {
+	return spanNearType{
+		"span_near": Object{
+			"clauses": clauses,
+		},
+	}
+}
+
+// SpanOr creates a span_or query, which matches the spans of any of its clauses.
+//
+// Parameters:
+//   - clauses: The span queries to combine.
+//
+// Returns:
+//
+//	An es.spanQueryType object containing the span_or query.
+func SpanOr(clauses ...spanClause) spanQueryType {
+	return spanQueryType{
+		"span_or": Object{
+			"clauses": clauses,
+		},
+	}
+}
+
+// SpanNot creates a span_not query, which matches the spans of include that do
+// not overlap with the spans of exclude. Pre, Post and Dist widen the excluded area.
+//
+// Example usage:
+//
+//	s := es.SpanNot(es.SpanTerm("body", "liability"), es.SpanTerm("body", "limited")).Pre(1)
+//	// s now contains {"span_not": {"exclude": {...}, "include": {...}, "pre": 1}}
+//
+// Parameters:
+//   - include: The span query whose spans are returned.
+//   - exclude: The span query whose spans must not overlap.
+//
+// Returns:
+//
+//	An es.spanNotType object containing the span_not query.
+func SpanNot(include, exclude spanClause) spanNotType {
+	return spanNotType{
+		"span_not": Object{
+			"include": include,
+			"exclude": exclude,
+		},
+	}
+}
+
+// SpanFirst creates a span_first query, which matches spans of match that end
+// within the first positions of the field.
+//
+// Example usage:
+//
+//	s := es.SpanFirst(es.SpanTerm("body", "whereas"), 5)
+//	// s now contains {"span_first": {"end": 5, "match": {"span_term": {...}}}}
+//
+// Parameters:
+//   - match: The span query to match.
+//   - end: The position the spans have to end before.
+//
+// Returns:
+//
+//	An es.spanQueryType object containing the span_first query.
+func SpanFirst(match spanClause, end int) spanQueryType {
+	return spanQueryType{
+		"span_first": Object{
+			"match": match,
+			"end":   end,
+		},
+	}
+}
+
+// SpanContaining creates a span_containing query, which returns the spans of
+// big that contain a span of little.
+//
+// Parameters:
+//   - big: The span query whose spans are returned.
+//   - little: The span query that has to be contained.
+//
+// Returns:
+//
+//	An es.spanQueryType object containing the span_containing query.
+func SpanContaining(big, little spanClause) spanQueryType {
+	return spanQueryType{
+		"span_containing": Object{
+			"big":    big,
+			"little": little,
+		},
+	}
+}
+
+// SpanWithin creates a span_within query, which returns the spans of little
+// that are inside a span of big.
+//
+// Parameters:
+//   - big: The span query that has to enclose the spans.
+//   - little: The span query whose spans are returned.
+//
+// Returns:
+//
+//	An es.spanQueryType object containing the span_within query.
+func SpanWithin(big, little spanClause) spanQueryType {
+	return spanQueryType{
+		"span_within": Object{
+			"big":    big,
+			"little": little,
+		},
+	}
+}
+
+// FieldMaskingSpan creates a field_masking_span query, which makes a span query
+// on one field look like a query on another field, so span_near or span_or can
+// combine spans of different fields, such as a field and its stemmed subfield.
+//
+// Example usage:
+//
+//	s := es.SpanNear(
+//		es.SpanTerm("body", "terminate"),
+//		es.FieldMaskingSpan(es.SpanTerm("body.stemmed", "notic"), "body"),
+//	).Slop(5)
+//
+// Parameters:
+//   - query: The span query to mask.
+//   - field: The field the query appears to run on.
+//
+// Returns:
+//
+//	An es.spanQueryType object containing the field_masking_span query.
+func FieldMaskingSpan(query spanClause, field string) spanQueryType {
+	return spanQueryType{
+		"field_masking_span": Object{
+			"query": query,
+			"field": field,
+		},
+	}
+}
+
+// Slop sets the maximum number of positions allowed between the clauses of the
+// span_near query.
+//
+// Parameters:
+//   - slop: The maximum number of intervening positions.
+//
+// Returns:
+//
+//	The updated es.spanNearType object with the "slop" field set.
+func (s spanNearType) Slop(slop int) spanNearType {
+	return genericPutInTheField(s, "span_near", "slop", slop)
+}
+
+// InOrder sets whether the clauses of the span_near query have to match in the
+// given order.
+//
+// Parameters:
+//   - inOrder: A boolean enabling or disabling ordered matching.
+//
+// Returns:
+//
+//	The updated es.spanNearType object with the "in_order" field set.
+func (s spanNearType) InOrder(inOrder bool) spanNearType {
+	return genericPutInTheField(s, "span_near", "in_order", inOrder)
+}
+
+// Boost sets the "boost" field in the span_near query.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.spanNearType object with the "boost" field set.
+func (s spanNearType) Boost(boost float64) spanNearType {
+	return genericPutInTheField(s, "span_near", "boost", boost)
+}
+
+// Pre sets how many positions before an include span must be free of exclude
+// spans.
+//
+// Parameters:
+//   - pre: The number of positions.
+//
+// Returns:
+//
+//	The updated es.spanNotType object with the "pre" field set.
+func (s spanNotType) Pre(pre int) spanNotType {
+	return genericPutInTheField(s, "span_not", "pre", pre)
+}
+
+// Post sets how many positions after an include span must be free of exclude
+// spans.
+//
+// Parameters:
+//   - post: The number of positions.
+//
+// Returns:
+//
+//	The updated es.spanNotType object with the "post" field set.
+func (s spanNotType) Post(post int) spanNotType {
+	return genericPutInTheField(s, "span_not", "post", post)
+}
+
+// Dist sets Pre and Post to the same number of positions.
+//
+// Parameters:
+//   - dist: The number of positions before and after.
+//
+// Returns:
+//
+//	The updated es.spanNotType object with the "dist" field set.
+func (s spanNotType) Dist(dist int) spanNotType {
+	return genericPutInTheField(s, "span_not", "dist", dist)
+}
+
+// Boost sets the "boost" field in the span_not query.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.spanNotType object with the "boost" field set.
+func (s spanNotType) Boost(boost float64) spanNotType {
+	return genericPutInTheField(s, "span_not", "boost", boost)
+}
+
+// Boost sets the "boost" field in the span query. For span_term queries it is
+// set next to the value of the field.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.spanQueryType object with the "boost" field set.
+func (s spanQueryType) Boost(boost float64) spanQueryType {
+	if _, ok := s["span_term"]; ok {
+		return genericPutInTheFieldOfFirstChild(s, "span_term", "boost", boost)
+	}
+	return genericPutInTheFieldOfFirstObject(s, "boost", boost)
+}
@@ -0,0 +1,143 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Span queries   ////
+
+func Test_Span_queries_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.SpanTerm)
+	assert.NotNil(t, es.SpanNear)
+	assert.NotNil(t, es.SpanOr)
+	assert.NotNil(t, es.SpanNot)
+	assert.NotNil(t, es.SpanFirst)
+	assert.NotNil(t, es.SpanContaining)
+	assert.NotNil(t, es.SpanWithin)
+	assert.NotNil(t, es.FieldMaskingSpan)
+}
+
+func Test_SpanTerm_should_create_spanQueryType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	span := es.SpanTerm("body", "indemnify").Boost(2)
+
+	// Then
+	assert.IsTypeString(t, "es.spanQueryType", span)
+	bodyJSON := assert.MarshalWithoutError(t, span)
+	assert.Equal(t, "{\"span_term\":{\"body\":{\"boost\":2,\"value\":\"indemnify\"}}}", bodyJSON)
+}
+
+func Test_SpanMulti_should_wrap_multi_term_queries(t *testing.T) {
+	t.Parallel()
+	// Given
+	prefix := es.SpanMulti(es.Prefix("body", "indemn"))
+	wildcard := es.SpanMulti(es.Wildcard("body", "termin*"))
+	regexp := es.SpanMulti(es.Regexp("body", "lia.*"))
+	fuzzy := es.SpanMulti(es.Fuzzy("body", "contrakt"))
+	rangeQuery := es.SpanMulti(es.Range("section").GreaterThanOrEqual(3))
+
+	// When Then
+	assert.Equal(t, "{\"span_multi\":{\"match\":{\"prefix\":{\"body\":{\"value\":\"indemn\"}}}}}", assert.MarshalWithoutError(t, prefix))
+	assert.Equal(t, "{\"span_multi\":{\"match\":{\"wildcard\":{\"body\":{\"value\":\"termin*\"}}}}}", assert.MarshalWithoutError(t, wildcard))
+	assert.Equal(t, "{\"span_multi\":{\"match\":{\"regexp\":{\"body\":{\"value\":\"lia.*\"}}}}}", assert.MarshalWithoutError(t, regexp))
+	assert.Equal(t, "{\"span_multi\":{\"match\":{\"fuzzy\":{\"body\":{\"value\":\"contrakt\"}}}}}", assert.MarshalWithoutError(t, fuzzy))
+	assert.Equal(t, "{\"span_multi\":{\"match\":{\"range\":{\"section\":{\"gte\":3}}}}}", assert.MarshalWithoutError(t, rangeQuery))
+}
+
+func Test_SpanNear_should_create_json_with_slop_and_in_order(t *testing.T) {
+	t.Parallel()
+	// Given
+	span := es.SpanNear(
+		es.SpanTerm("body", "breach"),
+		es.SpanMulti(es.Prefix("body", "contract")),
+	).Slop(3).InOrder(true).Boost(1.5)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, span)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"span_near\":{\"boost\":1.5,\"clauses\":[{\"span_term\":{\"body\":{\"value\":\"breach\"}}},{\"span_multi\":{\"match\":{\"prefix\":{\"body\":{\"value\":\"contract\"}}}}}],\"in_order\":true,\"slop\":3}}", bodyJSON)
+}
+
+func Test_SpanOr_should_create_json_with_clauses(t *testing.T) {
+	t.Parallel()
+	// Given
+	span := es.SpanOr(es.SpanTerm("body", "terminate"), es.SpanTerm("body", "cancel"))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, span)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"span_or\":{\"clauses\":[{\"span_term\":{\"body\":{\"value\":\"terminate\"}}},{\"span_term\":{\"body\":{\"value\":\"cancel\"}}}]}}", bodyJSON)
+}
+
+func Test_SpanNot_should_create_json_with_pre_post_and_dist(t *testing.T) {
+	t.Parallel()
+	// Given
+	span := es.SpanNot(es.SpanTerm("body", "liability"), es.SpanTerm("body", "limited")).
+		Pre(1).
+		Post(2).
+		Dist(3)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, span)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"span_not\":{\"dist\":3,\"exclude\":{\"span_term\":{\"body\":{\"value\":\"limited\"}}},\"include\":{\"span_term\":{\"body\":{\"value\":\"liability\"}}},\"post\":2,\"pre\":1}}", bodyJSON)
+}
+
+func Test_SpanNear_and_SpanNot_should_create_their_own_types(t *testing.T) {
+	t.Parallel()
+	// Given When
+	near := es.SpanNear(es.SpanTerm("body", "a"))
+	not := es.SpanNot(es.SpanTerm("body", "a"), es.SpanTerm("body", "b")).Boost(2)
+
+	// Then
+	assert.IsTypeString(t, "es.spanNearType", near)
+	assert.IsTypeString(t, "es.spanNotType", not)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"span_not\":{\"boost\":2,\"exclude\":{\"span_term\":{\"body\":{\"value\":\"b\"}}},\"include\":{\"span_term\":{\"body\":{\"value\":\"a\"}}}}}", assert.MarshalWithoutError(t, not))
+}
+
+func Test_SpanFirst_should_create_json_with_match_and_end(t *testing.T) {
+	t.Parallel()
+	// Given
+	span := es.SpanFirst(es.SpanTerm("body", "whereas"), 5)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, span)
+	assert.Equal(t, "{\"span_first\":{\"end\":5,\"match\":{\"span_term\":{\"body\":{\"value\":\"whereas\"}}}}}", bodyJSON)
+}
+
+func Test_SpanContaining_and_SpanWithin_should_create_json_with_big_and_little(t *testing.T) {
+	t.Parallel()
+	// Given
+	big := es.SpanNear(es.SpanTerm("body", "party"), es.SpanTerm("body", "agreement")).Slop(10)
+	containing := es.SpanContaining(big, es.SpanTerm("body", "shall"))
+	within := es.SpanWithin(big, es.SpanTerm("body", "shall"))
+
+	// When Then
+	// nolint:golint,lll
+	assert.Equal(t, "{\"span_containing\":{\"big\":{\"span_near\":{\"clauses\":[{\"span_term\":{\"body\":{\"value\":\"party\"}}},{\"span_term\":{\"body\":{\"value\":\"agreement\"}}}],\"slop\":10}},\"little\":{\"span_term\":{\"body\":{\"value\":\"shall\"}}}}}", assert.MarshalWithoutError(t, containing))
+	// nolint:golint,lll
+	assert.Equal(t, "{\"span_within\":{\"big\":{\"span_near\":{\"clauses\":[{\"span_term\":{\"body\":{\"value\":\"party\"}}},{\"span_term\":{\"body\":{\"value\":\"agreement\"}}}],\"slop\":10}},\"little\":{\"span_term\":{\"body\":{\"value\":\"shall\"}}}}}", assert.MarshalWithoutError(t, within))
+}
+
+func Test_FieldMaskingSpan_should_be_usable_inside_SpanNear_and_Bool(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Must(
+		es.SpanNear(
+			es.SpanTerm("body", "terminate"),
+			es.FieldMaskingSpan(es.SpanTerm("body.stemmed", "notic"), "body"),
+		).Slop(5),
+	))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"bool\":{\"must\":[{\"span_near\":{\"clauses\":[{\"span_term\":{\"body\":{\"value\":\"terminate\"}}},{\"field_masking_span\":{\"field\":\"body\",\"query\":{\"span_term\":{\"body.stemmed\":{\"value\":\"notic\"}}}}}],\"slop\":5}}]}}}", bodyJSON)
+}
@@ -0,0 +1,128 @@
+package es
+
+type sparseVectorType Object
+
+// SparseVector creates a new es.sparseVectorType object for a sparse_vector query,
+// which scores documents by the weighted tokens of a sparse_vector field.
+//
+// The query tokens are either computed from text by an inference endpoint, set
+// with InferenceID and Query, or given directly with QueryVector.
+//
+// Example usage:
+//
+//	s := es.SparseVector("content_embedding").InferenceID("my-elser-endpoint").Query("running shoes")
+//	// s now contains {"sparse_vector": {"field": "content_embedding", "inference_id": "my-elser-endpoint", "query": "running shoes"}}
+//
+// Parameters:
+//   - field: The name of the sparse_vector field.
+//
+// Returns:
+//
+//	An es.sparseVectorType object with the "field" set.
+func SparseVector(field string) sparseVectorType {
+	return sparseVectorType{
+		"sparse_vector": Object{
+			"field": field,
+		},
+	}
+}
+
+// InferenceID sets the inference endpoint that expands the query text into tokens.
+// It replaces a query vector set before.
+//
+// Parameters:
+//   - inferenceID: The id of the inference endpoint.
+//
+// Returns:
+//
+//	The updated es.sparseVectorType object with the "inference_id" field set.
+func (sv sparseVectorType) InferenceID(inferenceID string) sparseVectorType {
+	sv.deleteFromField("query_vector")
+	return sv.putInTheField("inference_id", inferenceID)
+}
+
+// Query sets the text expanded into tokens by the inference endpoint.
+//
+// Parameters:
+//   - query: The query text.
+//
+// Returns:
+//
+//	The updated es.sparseVectorType object with the "query" field set.
+func (sv sparseVectorType) Query(query string) sparseVectorType {
+	return sv.putInTheField("query", query)
+}
+
+// QueryVector sets precomputed tokens and their weights as the query. It
+// replaces an inference endpoint and query text set before.
+//
+// Example usage:
+//
+//	s := es.SparseVector("content_embedding").QueryVector(map[string]float64{"shoe": 1.2, "running": 0.8})
+//	// s now contains {"sparse_vector": {"field": "content_embedding", "query_vector": {"running": 0.8, "shoe": 1.2}}}
+//
+// Parameters:
+//   - queryVector: The tokens mapped to their weights.
+//
+// Returns:
+//
+//	The updated es.sparseVectorType object with the "query_vector" field set.
+func (sv sparseVectorType) QueryVector(queryVector map[string]float64) sparseVectorType {
+	sv.deleteFromField("inference_id", "query")
+	return sv.putInTheField("query_vector", queryVector)
+}
+
+// Prune sets whether frequent and low weight tokens are dropped from the query
+// to make it faster.
+//
+// Parameters:
+//   - prune: A boolean enabling or disabling token pruning.
+//
+// Returns:
+//
+//	The updated es.sparseVectorType object with the "prune" field set.
+func (sv sparseVectorType) Prune(prune bool) sparseVectorType {
+	return sv.putInTheField("prune", prune)
+}
+
+// PruningConfig sets the thresholds used to prune tokens when Prune is enabled.
+//
+// Example usage:
+//
+//	s := es.SparseVector("content_embedding").QueryVector(tokens).
+//		Prune(true).
+//		PruningConfig(es.PruningConfig().TokensFreqRatioThreshold(5))
+//
+// Parameters:
+//   - pruningConfig: An es.pruningConfigType created with es.PruningConfig.
+//
+// Returns:
+//
+//	The updated es.sparseVectorType object with the "pruning_config" field set.
+func (sv sparseVectorType) PruningConfig(pruningConfig pruningConfigType) sparseVectorType {
+	return sv.putInTheField("pruning_config", pruningConfig)
+}
+
+// Boost sets the "boost" field in the sparse_vector query.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.sparseVectorType object with the "boost" field set.
+func (sv sparseVectorType) Boost(boost float64) sparseVectorType {
+	return sv.putInTheField("boost", boost)
+}
+
+func (sv sparseVectorType) putInTheField(key string, value any) sparseVectorType {
+	return genericPutInTheField(sv, "sparse_vector", key, value)
+}
+
+func (sv sparseVectorType) deleteFromField(keys ...string) {
+	if sparseVector, ok := sv["sparse_vector"].(Object); ok {
+		for _, key := range keys {
+			delete(sparseVector, key)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   SparseVector   ////
+
+func Test_SparseVector_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.SparseVector)
+}
+
+func Test_SparseVector_should_create_sparseVectorType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	sparseVector := es.SparseVector("content_embedding")
+
+	// Then
+	assert.IsTypeString(t, "es.sparseVectorType", sparseVector)
+	bodyJSON := assert.MarshalWithoutError(t, sparseVector)
+	assert.Equal(t, "{\"sparse_vector\":{\"field\":\"content_embedding\"}}", bodyJSON)
+}
+
+func Test_SparseVector_should_create_json_with_inference(t *testing.T) {
+	t.Parallel()
+	// Given
+	sparseVector := es.SparseVector("content_embedding").
+		InferenceID("my-elser-endpoint").
+		Query("running shoes").
+		Prune(true).
+		PruningConfig(es.PruningConfig().TokensFreqRatioThreshold(5)).
+		Boost(2)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, sparseVector)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"sparse_vector\":{\"boost\":2,\"field\":\"content_embedding\",\"inference_id\":\"my-elser-endpoint\",\"prune\":true,\"pruning_config\":{\"tokens_freq_ratio_threshold\":5},\"query\":\"running shoes\"}}", bodyJSON)
+}
+
+func Test_SparseVector_query_vector_and_inference_should_replace_each_other(t *testing.T) {
+	t.Parallel()
+	// Given
+	withVector := es.SparseVector("content_embedding").
+		InferenceID("my-elser-endpoint").
+		Query("running shoes").
+		QueryVector(map[string]float64{"shoe": 1.2, "running": 0.8})
+	withInference := es.SparseVector("content_embedding").
+		QueryVector(map[string]float64{"shoe": 1.2}).
+		InferenceID("my-elser-endpoint")
+
+	// When Then
+	// nolint:golint,lll
+	assert.Equal(t, "{\"sparse_vector\":{\"field\":\"content_embedding\",\"query_vector\":{\"running\":0.8,\"shoe\":1.2}}}", assert.MarshalWithoutError(t, withVector))
+	assert.Equal(t, "{\"sparse_vector\":{\"field\":\"content_embedding\",\"inference_id\":\"my-elser-endpoint\"}}", assert.MarshalWithoutError(t, withInference))
+}
+
+func Test_SparseVector_should_be_usable_in_Bool_Should(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Should(
+		es.Match("title", "running shoes"),
+		es.SparseVector("content_embedding").InferenceID("elser").Query("running shoes"),
+	))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"bool\":{\"should\":[{\"match\":{\"title\":{\"query\":\"running shoes\"}}},{\"sparse_vector\":{\"field\":\"content_embedding\",\"inference_id\":\"elser\",\"query\":\"running shoes\"}}]}}}", bodyJSON)
+}
@@ -0,0 +1,53 @@
+package es
+
+type suggestType Object
+
+// Suggestion creates a named suggestion entry for es.Object.Suggest.
+//
+// Example usage:
+//
+//	s := es.Suggestion("title_fix", es.TermSuggester("title").Text("iphnoe"))
+//	// s now contains {"title_fix": {"term": {"field": "title"}, "text": "iphnoe"}}
+//
+// Parameters:
+//   - name: The name the suggestion is returned under in the "suggest" section of the response.
+//   - suggester: A suggester created with es.TermSuggester, es.PhraseSuggester or es.CompletionSuggester.
+//
+// Returns:
+//
+//	An es.suggestType holding the named suggestion.
+func Suggestion[T ~map[string]any](name string, suggester T) suggestType {
+	return suggestType{
+		name: suggester,
+	}
+}
+
+// Suggest adds named suggestions to the "suggest" section of an es.Object.
+// Suggestions of later calls are merged with the earlier ones.
+//
+// Example usage:
+//
+//	query := es.NewQuery(es.Match("title", "iphnoe")).Suggest(
+//		es.Suggestion("title_fix", es.TermSuggester("title").Text("iphnoe")),
+//		es.Suggestion("autocomplete", es.CompletionSuggester("suggest").Prefix("iph")),
+//	)
+//
+// Parameters:
+//   - suggestions: The es.suggestType entries created with es.Suggestion.
+//
+// Returns:
+//
+//	The updated es.Object with the "suggest" field set.
+func (o Object) Suggest(suggestions ...suggestType) Object {
+	suggest, ok := o["suggest"].(Object)
+	if !ok {
+		suggest = Object{}
+	}
+	for _, suggestion := range suggestions {
+		for name, suggester := range suggestion {
+			suggest[name] = suggester
+		}
+	}
+	o["suggest"] = suggest
+	return o
+}
@@ -0,0 +1,329 @@
+package es
+
+type completionSuggesterType Object
+
+type completionFuzzyType Object
+
+type categoryContextType Object
+
+type geoContextType Object
+
+// CompletionSuggester creates a completion suggester, which returns documents
+// whose completion field starts with the prefix, for search-as-you-type.
+//
+// Example usage:
+//
+//	s := es.CompletionSuggester("suggest").Prefix("iph").Size(5).SkipDuplicates(true)
+//	// s now contains {"completion": {"field": "suggest", "size": 5, "skip_duplicates": true}, "prefix": "iph"}
+//
+// Parameters:
+//   - field: The completion field suggestions are taken from.
+//
+// Returns:
+//
+//	An es.completionSuggesterType object with the "completion" field set.
+func CompletionSuggester(field string) completionSuggesterType {
+	return completionSuggesterType{
+		"completion": Object{
+			"field": field,
+		},
+	}
+}
+
+// Prefix sets the prefix the suggestions must start with.
+//
+// Parameters:
+//   - prefix: The text typed so far.
+//
+// Returns:
+//
+//	The updated es.completionSuggesterType object with the "prefix" field set.
+func (c completionSuggesterType) Prefix(prefix string) completionSuggesterType {
+	c["prefix"] = prefix
+	return c
+}
+
+// Regex sets a regular expression the suggestions must start with, instead of a prefix.
+//
+// Parameters:
+//   - regex: The regular expression.
+//
+// Returns:
+//
+//	The updated es.completionSuggesterType object with the "regex" field set.
+func (c completionSuggesterType) Regex(regex string) completionSuggesterType {
+	c["regex"] = regex
+	return c
+}
+
+// Size sets the maximum number of suggestions returned.
+//
+// Parameters:
+//   - size: The number of suggestions.
+//
+// Returns:
+//
+//	The updated es.completionSuggesterType object with "size" set inside "completion".
+func (c completionSuggesterType) Size(size int) completionSuggesterType {
+	return c.putInTheField("size", size)
+}
+
+// SkipDuplicates removes suggestions with the same text from different documents.
+//
+// Parameters:
+//   - skipDuplicates: A boolean enabling or disabling duplicate removal.
+//
+// Returns:
+//
+//	The updated es.completionSuggesterType object with "skip_duplicates" set inside "completion".
+func (c completionSuggesterType) SkipDuplicates(skipDuplicates bool) completionSuggesterType {
+	return c.putInTheField("skip_duplicates", skipDuplicates)
+}
+
+// Fuzzy makes the prefix match suggestions with typos.
+//
+// Example usage:
+//
+//	s := es.CompletionSuggester("suggest").Prefix("ihp").Fuzzy(es.CompletionFuzzy().Fuzziness("AUTO"))
+//
+// Parameters:
+//   - fuzzy: An es.completionFuzzyType created with es.CompletionFuzzy.
+//
+// Returns:
+//
+//	The updated es.completionSuggesterType object with "fuzzy" set inside "completion".
+func (c completionSuggesterType) Fuzzy(fuzzy completionFuzzyType) completionSuggesterType {
+	return c.putInTheField("fuzzy", fuzzy)
+}
+
+// CategoryContexts filters or boosts suggestions by the values of a category
+// context of the completion field.
+//
+// Example usage:
+//
+//	s := es.CompletionSuggester("suggest").Prefix("iph").
+//		CategoryContexts("brand", es.CategoryContext("apple").Boost(2), es.CategoryContext("samsung"))
+//
+// Parameters:
+//   - name: The name of the context in the completion field mapping.
+//   - contexts: The es.categoryContextType values created with es.CategoryContext.
+//
+// Returns:
+//
+//	The updated es.completionSuggesterType object with the context added to "contexts" inside "completion".
+func (c completionSuggesterType) CategoryContexts(name string, contexts ...categoryContextType) completionSuggesterType {
+	return c.putContexts(name, contexts)
+}
+
+// GeoContexts filters or boosts suggestions by the locations of a geo context
+// of the completion field.
+//
+// Example usage:
+//
+//	s := es.CompletionSuggester("suggest").Prefix("caf").
+//		GeoContexts("location", es.GeoContext(41.01, 28.97).Precision(5))
+//
+// Parameters:
+//   - name: The name of the context in the completion field mapping.
+//   - contexts: The es.geoContextType values created with es.GeoContext.
+//
+// Returns:
+//
+//	The updated es.completionSuggesterType object with the context added to "contexts" inside "completion".
+func (c completionSuggesterType) GeoContexts(name string, contexts ...geoContextType) completionSuggesterType {
+	return c.putContexts(name, contexts)
+}
+
+func (c completionSuggesterType) putContexts(name string, contexts any) completionSuggesterType {
+	completion, ok := c["completion"].(Object)
+	if !ok {
+		return c
+	}
+	completionContexts, ok := completion["contexts"].(Object)
+	if !ok {
+		completionContexts = Object{}
+		completion["contexts"] = completionContexts
+	}
+	completionContexts[name] = contexts
+	return c
+}
+
+func (c completionSuggesterType) putInTheField(key string, value any) completionSuggesterType {
+	return genericPutInTheField(c, "completion", key, value)
+}
+
+// CompletionFuzzy creates the fuzzy options of a completion suggester.
+//
+// Example usage:
+//
+//	f := es.CompletionFuzzy().Fuzziness(1).PrefixLength(2)
+//	// f now contains {"fuzziness": 1, "prefix_length": 2}
+//
+// Returns:
+//
+//	An empty es.completionFuzzyType object.
+func CompletionFuzzy() completionFuzzyType {
+	return completionFuzzyType{}
+}
+
+// Fuzziness sets the allowed edit distance.
+//
+// Parameters:
+//   - fuzziness: An edit distance such as 1, or "AUTO".
+//
+// Returns:
+//
+//	The updated es.completionFuzzyType object with the "fuzziness" field set.
+func (f completionFuzzyType) Fuzziness(fuzziness any) completionFuzzyType {
+	f["fuzziness"] = fuzziness
+	return f
+}
+
+// Transpositions sets whether swapping two adjacent characters counts as one edit.
+//
+// Parameters:
+//   - transpositions: A boolean enabling or disabling transpositions.
+//
+// Returns:
+//
+//	The updated es.completionFuzzyType object with the "transpositions" field set.
+func (f completionFuzzyType) Transpositions(transpositions bool) completionFuzzyType {
+	f["transpositions"] = transpositions
+	return f
+}
+
+// MinLength sets the minimum length of the input before fuzzy suggestions are returned.
+//
+// Parameters:
+//   - minLength: The minimum number of characters.
+//
+// Returns:
+//
+//	The updated es.completionFuzzyType object with the "min_length" field set.
+func (f completionFuzzyType) MinLength(minLength int) completionFuzzyType {
+	f["min_length"] = minLength
+	return f
+}
+
+// PrefixLength sets the number of leading characters that are not fuzzified.
+//
+// Parameters:
+//   - prefixLength: The number of characters.
+//
+// Returns:
+//
+//	The updated es.completionFuzzyType object with the "prefix_length" field set.
+func (f completionFuzzyType) PrefixLength(prefixLength int) completionFuzzyType {
+	f["prefix_length"] = prefixLength
+	return f
+}
+
+// UnicodeAware measures edits in unicode code points instead of bytes.
+//
+// Parameters:
+//   - unicodeAware: A boolean enabling or disabling unicode aware edits.
+//
+// Returns:
+//
+//	The updated es.completionFuzzyType object with the "unicode_aware" field set.
+func (f completionFuzzyType) UnicodeAware(unicodeAware bool) completionFuzzyType {
+	f["unicode_aware"] = unicodeAware
+	return f
+}
+
+// CategoryContext creates a value of a category context.
+//
+// Parameters:
+//   - value: The category suggestions are filtered by.
+//
+// Returns:
+//
+//	An es.categoryContextType object with the "context" field set.
+func CategoryContext(value string) categoryContextType {
+	return categoryContextType{
+		"context": value,
+	}
+}
+
+// Boost multiplies the score of suggestions in this category.
+//
+// Parameters:
+//   - boost: The boost factor.
+//
+// Returns:
+//
+//	The updated es.categoryContextType object with the "boost" field set.
+func (c categoryContextType) Boost(boost float64) categoryContextType {
+	c["boost"] = boost
+	return c
+}
+
+// Prefix makes the value match every category that starts with it.
+//
+// Parameters:
+//   - prefix: A boolean enabling or disabling prefix matching.
+//
+// Returns:
+//
+//	The updated es.categoryContextType object with the "prefix" field set.
+func (c categoryContextType) Prefix(prefix bool) categoryContextType {
+	c["prefix"] = prefix
+	return c
+}
+
+// GeoContext creates a location of a geo context.
+//
+// Parameters:
+//   - lat: The latitude.
+//   - lon: The longitude.
+//
+// Returns:
+//
+//	An es.geoContextType object with the "context" field set.
+func GeoContext(lat, lon float64) geoContextType {
+	return geoContextType{
+		"context": Object{
+			"lat": lat,
+			"lon": lon,
+		},
+	}
+}
+
+// Precision sets the geohash precision the location is matched with.
+//
+// Parameters:
+//   - precision: A geohash length such as 5, or a distance such as "1km".
+//
+// Returns:
+//
+//	The updated es.geoContextType object with the "precision" field set.
+func (g geoContextType) Precision(precision any) geoContextType {
+	g["precision"] = precision
+	return g
+}
+
+// Boost multiplies the score of suggestions near this location.
+//
+// Parameters:
+//   - boost: The boost factor.
+//
+// Returns:
+//
+//	The updated es.geoContextType object with the "boost" field set.
+func (g geoContextType) Boost(boost float64) geoContextType {
+	g["boost"] = boost
+	return g
+}
+
+// Neighbours also matches suggestions in the neighbouring cells of the given precisions.
+//
+// Parameters:
+//   - precisions: Geohash lengths or distances.
+//
+// Returns:
+//
+//	The updated es.geoContextType object with the "neighbours" field set.
+func (g geoContextType) Neighbours(precisions ...any) geoContextType {
+	g["neighbours"] = precisions
+	return g
+}
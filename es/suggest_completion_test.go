@@ -0,0 +1,72 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   CompletionSuggester   ////
+
+func Test_CompletionSuggester_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.CompletionSuggester)
+	assert.NotNil(t, es.CompletionFuzzy)
+	assert.NotNil(t, es.CategoryContext)
+	assert.NotNil(t, es.GeoContext)
+}
+
+func Test_CompletionSuggester_should_create_completionSuggesterType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	suggester := es.CompletionSuggester("suggest")
+
+	// Then
+	assert.IsTypeString(t, "es.completionSuggesterType", suggester)
+	assert.IsTypeString(t, "es.completionFuzzyType", es.CompletionFuzzy())
+	assert.IsTypeString(t, "es.categoryContextType", es.CategoryContext("apple"))
+	assert.IsTypeString(t, "es.geoContextType", es.GeoContext(41, 29))
+	bodyJSON := assert.MarshalWithoutError(t, suggester)
+	assert.Equal(t, "{\"completion\":{\"field\":\"suggest\"}}", bodyJSON)
+}
+
+func Test_CompletionSuggester_should_create_json_with_prefix_and_fuzzy_options(t *testing.T) {
+	t.Parallel()
+	// Given
+	suggester := es.CompletionSuggester("suggest").
+		Prefix("ihp").
+		Size(5).
+		SkipDuplicates(true).
+		Fuzzy(es.CompletionFuzzy().Fuzziness("AUTO").Transpositions(false).MinLength(3).PrefixLength(1).UnicodeAware(true))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, suggester)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"completion\":{\"field\":\"suggest\",\"fuzzy\":{\"fuzziness\":\"AUTO\",\"min_length\":3,\"prefix_length\":1,\"transpositions\":false,\"unicode_aware\":true},\"size\":5,\"skip_duplicates\":true},\"prefix\":\"ihp\"}", bodyJSON)
+}
+
+func Test_CompletionSuggester_Regex_should_create_json_with_regex_field(t *testing.T) {
+	t.Parallel()
+	// Given
+	suggester := es.CompletionSuggester("suggest").Regex("i[a-z]+ne")
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, suggester)
+	assert.Equal(t, "{\"completion\":{\"field\":\"suggest\"},\"regex\":\"i[a-z]+ne\"}", bodyJSON)
+}
+
+func Test_CompletionSuggester_should_create_json_with_category_and_geo_contexts(t *testing.T) {
+	t.Parallel()
+	// Given
+	suggester := es.CompletionSuggester("suggest").
+		Prefix("caf").
+		CategoryContexts("place_type", es.CategoryContext("cafe").Boost(2), es.CategoryContext("rest").Prefix(true)).
+		GeoContexts("location", es.GeoContext(41.01, 28.97).Precision(5).Boost(1.5).Neighbours(4, "10km"))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, suggester)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"completion\":{\"contexts\":{\"location\":[{\"boost\":1.5,\"context\":{\"lat\":41.01,\"lon\":28.97},\"neighbours\":[4,\"10km\"],\"precision\":5}],\"place_type\":[{\"boost\":2,\"context\":\"cafe\"},{\"context\":\"rest\",\"prefix\":true}]},\"field\":\"suggest\"},\"prefix\":\"caf\"}", bodyJSON)
+}
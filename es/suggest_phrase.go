@@ -0,0 +1,455 @@
+package es
+
+import SuggestMode "github.com/Trendyol/es-query-builder/es/enums/suggest-mode"
+
+type phraseSuggesterType Object
+
+type directGeneratorType Object
+
+type collateType Object
+
+type smoothingModelType Object
+
+// PhraseSuggester creates a phrase suggester, which suggests whole corrected
+// phrases using an n-gram language model built from the field.
+//
+// Example usage:
+//
+//	s := es.PhraseSuggester("title.trigram").
+//		Text("noble prize").
+//		DirectGenerator(es.DirectGenerator("title.trigram").SuggestMode(SuggestMode.Always)).
+//		Highlight("<em>", "</em>")
+//
+// Parameters:
+//   - field: The field the language model is built from, usually a shingle field.
+//
+// Returns:
+//
+//	An es.phraseSuggesterType object with the "phrase" field set.
+func PhraseSuggester(field string) phraseSuggesterType {
+	return phraseSuggesterType{
+		"phrase": Object{
+			"field": field,
+		},
+	}
+}
+
+// Text sets the text suggestions are made for.
+//
+// Parameters:
+//   - text: The text to correct.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with the "text" field set.
+func (p phraseSuggesterType) Text(text string) phraseSuggesterType {
+	p["text"] = text
+	return p
+}
+
+// Analyzer sets the analyzer the text is analyzed with.
+//
+// Parameters:
+//   - analyzer: The analyzer name.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "analyzer" set inside "phrase".
+func (p phraseSuggesterType) Analyzer(analyzer string) phraseSuggesterType {
+	return p.putInTheField("analyzer", analyzer)
+}
+
+// Size sets the maximum number of phrase suggestions returned.
+//
+// Parameters:
+//   - size: The number of suggestions.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "size" set inside "phrase".
+func (p phraseSuggesterType) Size(size int) phraseSuggesterType {
+	return p.putInTheField("size", size)
+}
+
+// ShardSize sets the maximum number of suggestions retrieved from each shard.
+//
+// Parameters:
+//   - shardSize: The number of suggestions per shard.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "shard_size" set inside "phrase".
+func (p phraseSuggesterType) ShardSize(shardSize int) phraseSuggesterType {
+	return p.putInTheField("shard_size", shardSize)
+}
+
+// GramSize sets the largest n-gram of the field, the max_shingle_size of its shingle filter.
+//
+// Parameters:
+//   - gramSize: The n-gram size.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "gram_size" set inside "phrase".
+func (p phraseSuggesterType) GramSize(gramSize int) phraseSuggesterType {
+	return p.putInTheField("gram_size", gramSize)
+}
+
+// RealWordErrorLikelihood sets the likelihood of a term being misspelled even
+// though it exists in the index.
+//
+// Parameters:
+//   - likelihood: A value between 0 and 1.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "real_word_error_likelihood" set inside "phrase".
+func (p phraseSuggesterType) RealWordErrorLikelihood(likelihood float64) phraseSuggesterType {
+	return p.putInTheField("real_word_error_likelihood", likelihood)
+}
+
+// Confidence sets the factor applied to the score of the input phrase that
+// suggestions must exceed to be returned.
+//
+// Parameters:
+//   - confidence: The confidence factor, 0 returns the top suggestions unconditionally.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "confidence" set inside "phrase".
+func (p phraseSuggesterType) Confidence(confidence float64) phraseSuggesterType {
+	return p.putInTheField("confidence", confidence)
+}
+
+// MaxErrors sets the maximum number, or fraction when below 1, of terms that
+// may be misspelled in a suggestion.
+//
+// Parameters:
+//   - maxErrors: The maximum number or fraction of corrected terms.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "max_errors" set inside "phrase".
+func (p phraseSuggesterType) MaxErrors(maxErrors float64) phraseSuggesterType {
+	return p.putInTheField("max_errors", maxErrors)
+}
+
+// Separator sets the separator between the terms of the bigram field.
+//
+// Parameters:
+//   - separator: The separator, a space by default.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "separator" set inside "phrase".
+func (p phraseSuggesterType) Separator(separator string) phraseSuggesterType {
+	return p.putInTheField("separator", separator)
+}
+
+// DirectGenerator adds generators producing the candidate terms of each position.
+//
+// Parameters:
+//   - generators: The es.directGeneratorType values created with es.DirectGenerator.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "direct_generator" set inside "phrase".
+func (p phraseSuggesterType) DirectGenerator(generators ...directGeneratorType) phraseSuggesterType {
+	phrase, ok := p["phrase"].(Object)
+	if !ok {
+		return p
+	}
+	directGenerators, ok := phrase["direct_generator"].([]directGeneratorType)
+	if !ok {
+		directGenerators = make([]directGeneratorType, 0, len(generators))
+	}
+	phrase["direct_generator"] = append(directGenerators, generators...)
+	return p
+}
+
+// Collate prunes the suggestions that do not match any document with the given query.
+//
+// Example usage:
+//
+//	s := es.PhraseSuggester("title.trigram").
+//		Collate(es.Collate(es.MatchPhrase("{{field_name}}", "{{suggestion}}")).Param("field_name", "title").Prune(true))
+//
+// Parameters:
+//   - collate: An es.collateType created with es.Collate.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "collate" set inside "phrase".
+func (p phraseSuggesterType) Collate(collate collateType) phraseSuggesterType {
+	return p.putInTheField("collate", collate)
+}
+
+// Smoothing sets the smoothing model balancing the weight of infrequent and frequent n-grams.
+//
+// Parameters:
+//   - model: A model created with es.StupidBackoff, es.Laplace or es.LinearInterpolation.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "smoothing" set inside "phrase".
+func (p phraseSuggesterType) Smoothing(model smoothingModelType) phraseSuggesterType {
+	return p.putInTheField("smoothing", model)
+}
+
+// Highlight wraps the corrected terms of each suggestion with the given tags,
+// which are returned in the "highlighted" field of the options.
+//
+// Parameters:
+//   - preTag: The tag inserted before corrected terms.
+//   - postTag: The tag inserted after corrected terms.
+//
+// Returns:
+//
+//	The updated es.phraseSuggesterType object with "highlight" set inside "phrase".
+func (p phraseSuggesterType) Highlight(preTag, postTag string) phraseSuggesterType {
+	return p.putInTheField("highlight", Object{
+		"pre_tag":  preTag,
+		"post_tag": postTag,
+	})
+}
+
+func (p phraseSuggesterType) putInTheField(key string, value any) phraseSuggesterType {
+	return genericPutInTheField(p, "phrase", key, value)
+}
+
+// DirectGenerator creates a candidate generator of a phrase suggester, which
+// works like a term suggester for each term of the text.
+//
+// Example usage:
+//
+//	g := es.DirectGenerator("title.trigram").SuggestMode(SuggestMode.Always).MinWordLength(3)
+//	// g now contains {"field": "title.trigram", "min_word_length": 3, "suggest_mode": "always"}
+//
+// Parameters:
+//   - field: The field candidates are taken from.
+//
+// Returns:
+//
+//	An es.directGeneratorType object with the "field" field set.
+func DirectGenerator(field string) directGeneratorType {
+	return directGeneratorType{
+		"field": field,
+	}
+}
+
+// Size sets the maximum number of candidates generated for each term.
+//
+// Parameters:
+//   - size: The number of candidates.
+//
+// Returns:
+//
+//	The updated es.directGeneratorType object with the "size" field set.
+func (d directGeneratorType) Size(size int) directGeneratorType {
+	d["size"] = size
+	return d
+}
+
+// SuggestMode sets which terms get candidates.
+//
+// Parameters:
+//   - suggestMode: A SuggestMode.SuggestMode value.
+//
+// Returns:
+//
+//	The updated es.directGeneratorType object with the "suggest_mode" field set.
+func (d directGeneratorType) SuggestMode(suggestMode SuggestMode.SuggestMode) directGeneratorType {
+	d["suggest_mode"] = suggestMode
+	return d
+}
+
+// MaxEdits sets the maximum edit distance of candidates, 1 or 2.
+//
+// Parameters:
+//   - maxEdits: The maximum edit distance.
+//
+// Returns:
+//
+//	The updated es.directGeneratorType object with the "max_edits" field set.
+func (d directGeneratorType) MaxEdits(maxEdits int) directGeneratorType {
+	d["max_edits"] = maxEdits
+	return d
+}
+
+// PrefixLength sets the number of leading characters that must match for a
+// term to be a candidate.
+//
+// Parameters:
+//   - prefixLength: The number of characters.
+//
+// Returns:
+//
+//	The updated es.directGeneratorType object with the "prefix_length" field set.
+func (d directGeneratorType) PrefixLength(prefixLength int) directGeneratorType {
+	d["prefix_length"] = prefixLength
+	return d
+}
+
+// MinWordLength sets the minimum length a term must have to get candidates.
+//
+// Parameters:
+//   - minWordLength: The minimum number of characters.
+//
+// Returns:
+//
+//	The updated es.directGeneratorType object with the "min_word_length" field set.
+func (d directGeneratorType) MinWordLength(minWordLength int) directGeneratorType {
+	d["min_word_length"] = minWordLength
+	return d
+}
+
+// MinDocFreq sets the minimum number, or fraction when below 1, of documents a
+// candidate must appear in.
+//
+// Parameters:
+//   - minDocFreq: The minimum document frequency.
+//
+// Returns:
+//
+//	The updated es.directGeneratorType object with the "min_doc_freq" field set.
+func (d directGeneratorType) MinDocFreq(minDocFreq float64) directGeneratorType {
+	d["min_doc_freq"] = minDocFreq
+	return d
+}
+
+// PreFilter sets the analyzer applied to each term before candidates are generated.
+//
+// Parameters:
+//   - analyzer: The analyzer name.
+//
+// Returns:
+//
+//	The updated es.directGeneratorType object with the "pre_filter" field set.
+func (d directGeneratorType) PreFilter(analyzer string) directGeneratorType {
+	d["pre_filter"] = analyzer
+	return d
+}
+
+// PostFilter sets the analyzer applied to each candidate before it is scored.
+//
+// Parameters:
+//   - analyzer: The analyzer name.
+//
+// Returns:
+//
+//	The updated es.directGeneratorType object with the "post_filter" field set.
+func (d directGeneratorType) PostFilter(analyzer string) directGeneratorType {
+	d["post_filter"] = analyzer
+	return d
+}
+
+// Collate creates the collate section of a phrase suggester. The query is a
+// mustache template in which {{suggestion}} is replaced by each suggestion.
+//
+// Example usage:
+//
+//	c := es.Collate(es.MatchPhrase("title", "{{suggestion}}")).Prune(true)
+//	// c now contains {"prune": true, "query": {"source": {"match_phrase": {"title": {"query": "{{suggestion}}"}}}}}
+//
+// Parameters:
+//   - queryClause: The query template, built with the es query builders.
+//
+// Returns:
+//
+//	An es.collateType object with the "query" field set.
+func Collate(queryClause any) collateType {
+	c := collateType{}
+	if field, ok := correctType(queryClause); ok {
+		c["query"] = Object{
+			"source": field,
+		}
+	}
+	return c
+}
+
+// Param sets a parameter of the query template, next to {{suggestion}}.
+//
+// Parameters:
+//   - key: The parameter name.
+//   - value: The parameter value.
+//
+// Returns:
+//
+//	The updated es.collateType object with the parameter added to "params".
+func (c collateType) Param(key string, value any) collateType {
+	params, ok := c["params"].(Object)
+	if !ok {
+		params = Object{}
+		c["params"] = params
+	}
+	params[key] = value
+	return c
+}
+
+// Prune keeps the suggestions that do not match, with "collate_match" set to
+// false on their options, instead of removing them.
+//
+// Parameters:
+//   - prune: A boolean enabling or disabling pruning.
+//
+// Returns:
+//
+//	The updated es.collateType object with the "prune" field set.
+func (c collateType) Prune(prune bool) collateType {
+	c["prune"] = prune
+	return c
+}
+
+// StupidBackoff creates the stupid_backoff smoothing model, which backs off to
+// lower order n-grams with a discount when a higher order n-gram is missing.
+//
+// Parameters:
+//   - discount: The factor lower order n-gram scores are multiplied with.
+//
+// Returns:
+//
+//	An es.smoothingModelType object.
+func StupidBackoff(discount float64) smoothingModelType {
+	return smoothingModelType{
+		"stupid_backoff": Object{
+			"discount": discount,
+		},
+	}
+}
+
+// Laplace creates the laplace smoothing model, which uses additive smoothing.
+//
+// Parameters:
+//   - alpha: The constant added to all counts.
+//
+// Returns:
+//
+//	An es.smoothingModelType object.
+func Laplace(alpha float64) smoothingModelType {
+	return smoothingModelType{
+		"laplace": Object{
+			"alpha": alpha,
+		},
+	}
+}
+
+// LinearInterpolation creates the linear_interpolation smoothing model, which
+// takes the weighted mean of the trigram, bigram and unigram scores.
+//
+// Parameters:
+//   - trigramLambda: The weight of trigrams.
+//   - bigramLambda: The weight of bigrams.
+//   - unigramLambda: The weight of unigrams.
+//
+// Returns:
+//
+//	An es.smoothingModelType object.
+func LinearInterpolation(trigramLambda, bigramLambda, unigramLambda float64) smoothingModelType {
+	return smoothingModelType{
+		"linear_interpolation": Object{
+			"trigram_lambda": trigramLambda,
+			"bigram_lambda":  bigramLambda,
+			"unigram_lambda": unigramLambda,
+		},
+	}
+}
@@ -0,0 +1,103 @@
+package es_test
+
+import (
+	"testing"
+
+	SuggestMode "github.com/Trendyol/es-query-builder/es/enums/suggest-mode"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   PhraseSuggester   ////
+
+func Test_PhraseSuggester_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.PhraseSuggester)
+	assert.NotNil(t, es.DirectGenerator)
+	assert.NotNil(t, es.Collate)
+}
+
+func Test_PhraseSuggester_should_create_phraseSuggesterType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	suggester := es.PhraseSuggester("title.trigram")
+
+	// Then
+	assert.IsTypeString(t, "es.phraseSuggesterType", suggester)
+	assert.IsTypeString(t, "es.directGeneratorType", es.DirectGenerator("title"))
+	assert.IsTypeString(t, "es.collateType", es.Collate(nil))
+	assert.IsTypeString(t, "es.smoothingModelType", es.Laplace(0.5))
+	bodyJSON := assert.MarshalWithoutError(t, suggester)
+	assert.Equal(t, "{\"phrase\":{\"field\":\"title.trigram\"}}", bodyJSON)
+}
+
+func Test_PhraseSuggester_should_create_json_with_all_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	suggester := es.PhraseSuggester("title.trigram").
+		Text("noble prize").
+		Analyzer("trigram").
+		Size(1).
+		ShardSize(5).
+		GramSize(3).
+		RealWordErrorLikelihood(0.95).
+		Confidence(1).
+		MaxErrors(2).
+		Separator(" ").
+		Highlight("<em>", "</em>")
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, suggester)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"phrase\":{\"analyzer\":\"trigram\",\"confidence\":1,\"field\":\"title.trigram\",\"gram_size\":3,\"highlight\":{\"post_tag\":\"\\u003c/em\\u003e\",\"pre_tag\":\"\\u003cem\\u003e\"},\"max_errors\":2,\"real_word_error_likelihood\":0.95,\"separator\":\" \",\"shard_size\":5,\"size\":1},\"text\":\"noble prize\"}", bodyJSON)
+}
+
+func Test_PhraseSuggester_DirectGenerator_should_append_generators(t *testing.T) {
+	t.Parallel()
+	// Given
+	suggester := es.PhraseSuggester("title.trigram").
+		DirectGenerator(es.DirectGenerator("title.trigram").SuggestMode(SuggestMode.Always).MinWordLength(1)).
+		DirectGenerator(
+			es.DirectGenerator("title.reverse").
+				Size(5).
+				MaxEdits(2).
+				PrefixLength(0).
+				MinDocFreq(0.1).
+				PreFilter("reverse").
+				PostFilter("reverse"),
+		)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, suggester)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"phrase\":{\"direct_generator\":[{\"field\":\"title.trigram\",\"min_word_length\":1,\"suggest_mode\":\"always\"},{\"field\":\"title.reverse\",\"max_edits\":2,\"min_doc_freq\":0.1,\"post_filter\":\"reverse\",\"pre_filter\":\"reverse\",\"prefix_length\":0,\"size\":5}],\"field\":\"title.trigram\"}}", bodyJSON)
+}
+
+func Test_PhraseSuggester_Collate_should_create_json_with_query_template(t *testing.T) {
+	t.Parallel()
+	// Given
+	suggester := es.PhraseSuggester("title.trigram").Collate(
+		es.Collate(es.MatchPhrase("{{field_name}}", "{{suggestion}}")).Param("field_name", "title").Prune(true),
+	)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, suggester)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"phrase\":{\"collate\":{\"params\":{\"field_name\":\"title\"},\"prune\":true,\"query\":{\"source\":{\"match_phrase\":{\"{{field_name}}\":{\"query\":\"{{suggestion}}\"}}}}},\"field\":\"title.trigram\"}}", bodyJSON)
+}
+
+func Test_PhraseSuggester_Smoothing_should_create_json_with_each_model(t *testing.T) {
+	t.Parallel()
+	// Given
+	stupidBackoff := es.PhraseSuggester("f").Smoothing(es.StupidBackoff(0.4))
+	laplace := es.PhraseSuggester("f").Smoothing(es.Laplace(0.7))
+	linear := es.PhraseSuggester("f").Smoothing(es.LinearInterpolation(0.5, 0.3, 0.2))
+
+	// When Then
+	assert.Equal(t, "{\"phrase\":{\"field\":\"f\",\"smoothing\":{\"stupid_backoff\":{\"discount\":0.4}}}}", assert.MarshalWithoutError(t, stupidBackoff))
+	assert.Equal(t, "{\"phrase\":{\"field\":\"f\",\"smoothing\":{\"laplace\":{\"alpha\":0.7}}}}", assert.MarshalWithoutError(t, laplace))
+	// nolint:golint,lll
+	assert.Equal(t, "{\"phrase\":{\"field\":\"f\",\"smoothing\":{\"linear_interpolation\":{\"bigram_lambda\":0.3,\"trigram_lambda\":0.5,\"unigram_lambda\":0.2}}}}", assert.MarshalWithoutError(t, linear))
+}
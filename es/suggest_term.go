@@ -0,0 +1,170 @@
+package es
+
+import (
+	SuggestMode "github.com/Trendyol/es-query-builder/es/enums/suggest-mode"
+	SuggestSort "github.com/Trendyol/es-query-builder/es/enums/suggest-sort"
+)
+
+type termSuggesterType Object
+
+// TermSuggester creates a term suggester, which suggests corrections for each
+// term of the text based on edit distance.
+//
+// Example usage:
+//
+//	s := es.TermSuggester("title").Text("iphnoe case").SuggestMode(SuggestMode.Popular).MaxEdits(2)
+//	// s now contains {"term": {"field": "title", "max_edits": 2, "suggest_mode": "popular"}, "text": "iphnoe case"}
+//
+// Parameters:
+//   - field: The field suggestions are taken from.
+//
+// Returns:
+//
+//	An es.termSuggesterType object with the "term" field set.
+func TermSuggester(field string) termSuggesterType {
+	return termSuggesterType{
+		"term": Object{
+			"field": field,
+		},
+	}
+}
+
+// Text sets the text suggestions are made for.
+//
+// Parameters:
+//   - text: The text to correct.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with the "text" field set.
+func (t termSuggesterType) Text(text string) termSuggesterType {
+	t["text"] = text
+	return t
+}
+
+// Analyzer sets the analyzer the text is analyzed with.
+//
+// Parameters:
+//   - analyzer: The analyzer name.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with "analyzer" set inside "term".
+func (t termSuggesterType) Analyzer(analyzer string) termSuggesterType {
+	return t.putInTheField("analyzer", analyzer)
+}
+
+// Size sets the maximum number of suggestions returned for each term.
+//
+// Parameters:
+//   - size: The number of suggestions.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with "size" set inside "term".
+func (t termSuggesterType) Size(size int) termSuggesterType {
+	return t.putInTheField("size", size)
+}
+
+// ShardSize sets the maximum number of suggestions retrieved from each shard.
+//
+// Parameters:
+//   - shardSize: The number of suggestions per shard.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with "shard_size" set inside "term".
+func (t termSuggesterType) ShardSize(shardSize int) termSuggesterType {
+	return t.putInTheField("shard_size", shardSize)
+}
+
+// SuggestMode sets which terms get suggestions.
+//
+// Parameters:
+//   - suggestMode: A SuggestMode.SuggestMode value.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with "suggest_mode" set inside "term".
+func (t termSuggesterType) SuggestMode(suggestMode SuggestMode.SuggestMode) termSuggesterType {
+	return t.putInTheField("suggest_mode", suggestMode)
+}
+
+// MaxEdits sets the maximum edit distance of suggestions, 1 or 2.
+//
+// Parameters:
+//   - maxEdits: The maximum edit distance.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with "max_edits" set inside "term".
+func (t termSuggesterType) MaxEdits(maxEdits int) termSuggesterType {
+	return t.putInTheField("max_edits", maxEdits)
+}
+
+// Sort sets how the suggestions of each term are ordered.
+//
+// Parameters:
+//   - sort: A SuggestSort.SuggestSort value.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with "sort" set inside "term".
+func (t termSuggesterType) Sort(sort SuggestSort.SuggestSort) termSuggesterType {
+	return t.putInTheField("sort", sort)
+}
+
+// PrefixLength sets the number of leading characters that must match for a
+// term to be suggested.
+//
+// Parameters:
+//   - prefixLength: The number of characters.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with "prefix_length" set inside "term".
+func (t termSuggesterType) PrefixLength(prefixLength int) termSuggesterType {
+	return t.putInTheField("prefix_length", prefixLength)
+}
+
+// MinWordLength sets the minimum length a term must have to get suggestions.
+//
+// Parameters:
+//   - minWordLength: The minimum number of characters.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with "min_word_length" set inside "term".
+func (t termSuggesterType) MinWordLength(minWordLength int) termSuggesterType {
+	return t.putInTheField("min_word_length", minWordLength)
+}
+
+// MinDocFreq sets the minimum number, or fraction when below 1, of documents a
+// suggestion must appear in.
+//
+// Parameters:
+//   - minDocFreq: The minimum document frequency.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with "min_doc_freq" set inside "term".
+func (t termSuggesterType) MinDocFreq(minDocFreq float64) termSuggesterType {
+	return t.putInTheField("min_doc_freq", minDocFreq)
+}
+
+// MaxTermFreq sets the maximum number, or fraction when below 1, of documents a
+// term may appear in to get suggestions, which skips correcting frequent terms.
+//
+// Parameters:
+//   - maxTermFreq: The maximum term frequency.
+//
+// Returns:
+//
+//	The updated es.termSuggesterType object with "max_term_freq" set inside "term".
+func (t termSuggesterType) MaxTermFreq(maxTermFreq float64) termSuggesterType {
+	return t.putInTheField("max_term_freq", maxTermFreq)
+}
+
+func (t termSuggesterType) putInTheField(key string, value any) termSuggesterType {
+	return genericPutInTheField(t, "term", key, value)
+}
@@ -0,0 +1,52 @@
+package es_test
+
+import (
+	"testing"
+
+	SuggestMode "github.com/Trendyol/es-query-builder/es/enums/suggest-mode"
+	SuggestSort "github.com/Trendyol/es-query-builder/es/enums/suggest-sort"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   TermSuggester   ////
+
+func Test_TermSuggester_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.TermSuggester)
+}
+
+func Test_TermSuggester_should_create_termSuggesterType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	suggester := es.TermSuggester("title")
+
+	// Then
+	assert.IsTypeString(t, "es.termSuggesterType", suggester)
+	bodyJSON := assert.MarshalWithoutError(t, suggester)
+	assert.Equal(t, "{\"term\":{\"field\":\"title\"}}", bodyJSON)
+}
+
+func Test_TermSuggester_should_create_json_with_all_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	suggester := es.TermSuggester("title").
+		Text("iphnoe case").
+		Analyzer("standard").
+		Size(3).
+		ShardSize(10).
+		SuggestMode(SuggestMode.Popular).
+		MaxEdits(2).
+		Sort(SuggestSort.Frequency).
+		PrefixLength(1).
+		MinWordLength(4).
+		MinDocFreq(0.01).
+		MaxTermFreq(0.5)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, suggester)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"term\":{\"analyzer\":\"standard\",\"field\":\"title\",\"max_edits\":2,\"max_term_freq\":0.5,\"min_doc_freq\":0.01,\"min_word_length\":4,\"prefix_length\":1,\"shard_size\":10,\"size\":3,\"sort\":\"frequency\",\"suggest_mode\":\"popular\"},\"text\":\"iphnoe case\"}", bodyJSON)
+}
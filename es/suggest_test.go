@@ -0,0 +1,43 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Suggest   ////
+
+func Test_Suggestion_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.Suggestion[es.Object])
+}
+
+func Test_Suggestion_should_create_suggestType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	suggestion := es.Suggestion("title_fix", es.TermSuggester("title"))
+
+	// Then
+	assert.IsTypeString(t, "es.suggestType", suggestion)
+	bodyJSON := assert.MarshalWithoutError(t, suggestion)
+	assert.Equal(t, "{\"title_fix\":{\"term\":{\"field\":\"title\"}}}", bodyJSON)
+}
+
+func Test_Suggest_should_add_suggest_field_into_Object(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.MatchAll()).Suggest(
+		es.Suggestion("title_fix", es.TermSuggester("title").Text("iphnoe")),
+	)
+
+	// When
+	query = query.Suggest(es.Suggestion("autocomplete", es.CompletionSuggester("suggest").Prefix("iph")))
+
+	// Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"match_all\":{}},\"suggest\":{\"autocomplete\":{\"completion\":{\"field\":\"suggest\"},\"prefix\":\"iph\"},\"title_fix\":{\"term\":{\"field\":\"title\"},\"text\":\"iphnoe\"}}}", bodyJSON)
+}
@@ -0,0 +1,63 @@
+package es
+
+type textExpansionType Object
+
+// TextExpansion creates a new es.textExpansionType object for a text_expansion
+// query, which expands the text into weighted tokens with a deployed model and
+// searches a sparse_vector or rank_features field with them.
+//
+// The text_expansion query is deprecated in newer Elasticsearch versions in
+// favour of es.SparseVector, and is kept for clusters that still use it.
+//
+// Example usage:
+//
+//	t := es.TextExpansion("content_embedding", ".elser_model_2", "running shoes")
+//	// t now contains {"text_expansion": {"content_embedding": {"model_id": ".elser_model_2", "model_text": "running shoes"}}}
+//
+// Parameters:
+//   - field: The name of the field holding the tokens.
+//   - modelID: The id of the model that expands the text.
+//   - modelText: The query text.
+//
+// Returns:
+//
+//	An es.textExpansionType object containing the text_expansion query.
+func TextExpansion(field, modelID, modelText string) textExpansionType {
+	return textExpansionType{
+		"text_expansion": Object{
+			field: Object{
+				"model_id":   modelID,
+				"model_text": modelText,
+			},
+		},
+	}
+}
+
+// PruningConfig sets the thresholds used to drop frequent and low weight tokens
+// from the query.
+//
+// Parameters:
+//   - pruningConfig: An es.pruningConfigType created with es.PruningConfig.
+//
+// Returns:
+//
+//	The updated es.textExpansionType object with the "pruning_config" field set.
+func (te textExpansionType) PruningConfig(pruningConfig pruningConfigType) textExpansionType {
+	return te.putInTheField("pruning_config", pruningConfig)
+}
+
+// Boost sets the "boost" field in the text_expansion query.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.textExpansionType object with the "boost" field set.
+func (te textExpansionType) Boost(boost float64) textExpansionType {
+	return te.putInTheField("boost", boost)
+}
+
+func (te textExpansionType) putInTheField(key string, value any) textExpansionType {
+	return genericPutInTheFieldOfFirstChild(te, "text_expansion", key, value)
+}
@@ -0,0 +1,41 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   TextExpansion   ////
+
+func Test_TextExpansion_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.TextExpansion)
+}
+
+func Test_TextExpansion_should_create_textExpansionType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	textExpansion := es.TextExpansion("content_embedding", ".elser_model_2", "running shoes")
+
+	// Then
+	assert.IsTypeString(t, "es.textExpansionType", textExpansion)
+	bodyJSON := assert.MarshalWithoutError(t, textExpansion)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"text_expansion\":{\"content_embedding\":{\"model_id\":\".elser_model_2\",\"model_text\":\"running shoes\"}}}", bodyJSON)
+}
+
+func Test_TextExpansion_should_create_json_with_pruning_config_and_boost(t *testing.T) {
+	t.Parallel()
+	// Given
+	textExpansion := es.TextExpansion("content_embedding", ".elser_model_2", "running shoes").
+		PruningConfig(es.PruningConfig().TokensWeightThreshold(0.4)).
+		Boost(3)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, textExpansion)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"text_expansion\":{\"content_embedding\":{\"boost\":3,\"model_id\":\".elser_model_2\",\"model_text\":\"running shoes\",\"pruning_config\":{\"tokens_weight_threshold\":0.4}}}}", bodyJSON)
+}
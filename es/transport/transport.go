@@ -0,0 +1,87 @@
+// Package transport defines the function the request helpers of this module,
+// such as es/pit and es/scroll, use to talk to Elasticsearch. Keeping it a plain
+// function lets callers plug in any client and lets tests use a local stand-in.
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Trendyol/es-query-builder/es/response"
+)
+
+// Func sends a request to Elasticsearch and returns the raw response body.
+// Responses with a non 2xx status must be returned as errors.
+//
+// Parameters:
+//   - ctx: The context of the request.
+//   - method: The HTTP method, such as http.MethodPost.
+//   - path: The path and query string, starting with "/".
+//   - body: The JSON request body, or nil for requests without a body.
+//
+// Returns:
+//
+//	The raw JSON response body, or an error.
+type Func func(ctx context.Context, method, path string, body []byte) ([]byte, error)
+
+// HTTP creates a transport.Func sending requests with the given client to the
+// cluster at baseURL. Error responses are returned as *es/response.ResponseError
+// when their body holds an Elasticsearch error.
+//
+// Example usage:
+//
+//	do := transport.HTTP(http.DefaultClient, "http://localhost:9200")
+//	body, err := do(ctx, http.MethodGet, "/_cluster/health", nil)
+//
+// Parameters:
+//   - client: The http.Client used to send requests.
+//   - baseURL: The URL of the cluster, without a trailing path.
+//
+// Returns:
+//
+//	A transport.Func sending requests over HTTP.
+func HTTP(client *http.Client, baseURL string) Func {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return func(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+		var reader io.Reader
+		if body != nil {
+			reader = bytes.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, baseURL+path, reader)
+		if err != nil {
+			return nil, err
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		res, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		data, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+			return nil, statusError(method, path, res.StatusCode, data)
+		}
+		return data, nil
+	}
+}
+
+func statusError(method, path string, status int, data []byte) error {
+	var responseError response.ResponseError
+	if err := json.Unmarshal(data, &responseError); err == nil && responseError.Cause.Type != "" {
+		if responseError.Status == 0 {
+			responseError.Status = status
+		}
+		return &responseError
+	}
+	return fmt.Errorf("%s %s: unexpected status %d", method, path, status)
+}
@@ -0,0 +1,85 @@
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es/response"
+	"github.com/Trendyol/es-query-builder/es/transport"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+func Test_HTTP_should_send_request_and_return_body(t *testing.T) {
+	t.Parallel()
+	// Given
+	var method, path, contentType, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		method, path, contentType, body = r.Method, r.URL.RequestURI(), r.Header.Get("Content-Type"), string(data)
+		_, _ = w.Write([]byte(`{"count":1}`))
+	}))
+	defer server.Close()
+	do := transport.HTTP(server.Client(), server.URL+"/")
+
+	// When
+	data, err := do(context.Background(), http.MethodPost, "/products/_count?pretty", []byte(`{"query":{}}`))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, `{"count":1}`, string(data))
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "/products/_count?pretty", path)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, `{"query":{}}`, body)
+}
+
+func Test_HTTP_should_return_response_error_for_error_status(t *testing.T) {
+	t.Parallel()
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing/_search":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"type":"index_not_found_exception","reason":"no such index [missing]"},"status":404}`))
+		case "/_pit":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"type":"search_context_missing_exception","reason":"gone"}}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+	do := transport.HTTP(server.Client(), server.URL)
+
+	// When
+	_, notFoundErr := do(context.Background(), http.MethodGet, "/missing/_search", nil)
+	_, noStatusErr := do(context.Background(), http.MethodDelete, "/_pit", []byte(`{}`))
+	_, gatewayErr := do(context.Background(), http.MethodGet, "/", nil)
+
+	// Then
+	var responseError *response.ResponseError
+	assert.True(t, errors.As(notFoundErr, &responseError))
+	assert.Equal(t, "404 index_not_found_exception: no such index [missing]", notFoundErr.Error())
+	assert.Equal(t, "404 search_context_missing_exception: gone", noStatusErr.Error())
+	assert.Equal(t, "GET /: unexpected status 502", gatewayErr.Error())
+}
+
+func Test_HTTP_should_return_error_when_request_fails(t *testing.T) {
+	t.Parallel()
+	// Given
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	do := transport.HTTP(http.DefaultClient, server.URL)
+
+	// When
+	_, sendErr := do(context.Background(), http.MethodGet, "/", nil)
+	_, methodErr := do(context.Background(), "BAD METHOD", "/", nil)
+
+	// Then
+	assert.NotNil(t, sendErr)
+	assert.NotNil(t, methodErr)
+}
@@ -0,0 +1,97 @@
+package es
+
+import Conflicts "github.com/Trendyol/es-query-builder/es/enums/conflicts"
+
+type updateByQueryType Object
+
+// UpdateByQuery creates the body of an _update_by_query request, which runs a
+// script on every document matching the query.
+//
+// Example usage:
+//
+//	body := es.UpdateByQuery(es.Term("status", "draft")).
+//		Script(es.ScriptSource("ctx._source.status = params.status", ScriptLanguage.Painless).Parameter("status", "published")).
+//		Conflicts(Conflicts.Proceed)
+//	// body now contains the "query", "script" and "conflicts" fields.
+//
+// Parameters:
+//   - queryClause: The query selecting the documents to update. A nil query clause
+//     is omitted, so every document of the target indices is updated.
+//
+// Returns:
+//
+//	An es.updateByQueryType object with the "query" field set.
+func UpdateByQuery(queryClause any) updateByQueryType {
+	u := updateByQueryType{}
+	if field, ok := correctType(queryClause); ok {
+		u["query"] = field
+	}
+	return u
+}
+
+// Script sets the script run on each matching document.
+//
+// Example usage:
+//
+//	body := es.UpdateByQuery(es.Exists("legacy_price")).
+//		Script(es.ScriptSource("ctx._source.remove('legacy_price')", ScriptLanguage.Painless))
+//
+// Parameters:
+//   - script: An es.scriptType created with es.ScriptSource or es.ScriptID.
+//
+// Returns:
+//
+//	The updated es.updateByQueryType object with the "script" field set.
+func (u updateByQueryType) Script(script scriptType) updateByQueryType {
+	u["script"] = script
+	return u
+}
+
+// MaxDocs sets the maximum number of documents the request processes.
+//
+// Parameters:
+//   - maxDocs: The maximum number of documents to update.
+//
+// Returns:
+//
+//	The updated es.updateByQueryType object with the "max_docs" field set.
+func (u updateByQueryType) MaxDocs(maxDocs int) updateByQueryType {
+	u["max_docs"] = maxDocs
+	return u
+}
+
+// Conflicts sets whether the request aborts or proceeds on version conflicts.
+//
+// Parameters:
+//   - conflicts: A Conflicts.Conflicts value.
+//
+// Returns:
+//
+//	The updated es.updateByQueryType object with the "conflicts" field set.
+func (u updateByQueryType) Conflicts(conflicts Conflicts.Conflicts) updateByQueryType {
+	u["conflicts"] = conflicts
+	return u
+}
+
+// Slice makes the request process a single slice of the matching documents, so
+// a job can split the work across several manually sliced requests.
+//
+// Example usage:
+//
+//	body := es.UpdateByQuery(es.MatchAll()).Slice(0, 2)
+//	// body now contains {"query": {"match_all": {}}, "slice": {"id": 0, "max": 2}}
+//
+// Parameters:
+//   - id: The zero based id of the slice.
+//   - maxSlices: The total number of slices.
+//
+// Returns:
+//
+//	The updated es.updateByQueryType object with the "slice" field set.
+func (u updateByQueryType) Slice(id, maxSlices int) updateByQueryType {
+	u["slice"] = Object{
+		"id":  id,
+		"max": maxSlices,
+	}
+	return u
+}
@@ -0,0 +1,65 @@
+package es_test
+
+import (
+	"testing"
+
+	Conflicts "github.com/Trendyol/es-query-builder/es/enums/conflicts"
+	ScriptLanguage "github.com/Trendyol/es-query-builder/es/enums/script-language"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   UpdateByQuery   ////
+
+func Test_UpdateByQuery_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.UpdateByQuery)
+}
+
+func Test_UpdateByQuery_should_create_updateByQueryType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	body := es.UpdateByQuery(es.Term("status", "draft"))
+
+	// Then
+	assert.IsTypeString(t, "es.updateByQueryType", body)
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	assert.Equal(t, "{\"query\":{\"term\":{\"status\":{\"value\":\"draft\"}}}}", bodyJSON)
+}
+
+func Test_UpdateByQuery_should_omit_nil_query(t *testing.T) {
+	t.Parallel()
+	// Given When
+	body := es.UpdateByQuery(nil).MaxDocs(100)
+
+	// Then
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	assert.Equal(t, "{\"max_docs\":100}", bodyJSON)
+}
+
+func Test_UpdateByQuery_should_wrap_bool_query(t *testing.T) {
+	t.Parallel()
+	// Given When
+	body := es.UpdateByQuery(es.Bool().Filter(es.Exists("legacy_price")))
+
+	// Then
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	assert.Equal(t, "{\"query\":{\"bool\":{\"filter\":[{\"exists\":{\"field\":\"legacy_price\"}}]}}}", bodyJSON)
+}
+
+func Test_UpdateByQuery_should_create_json_with_all_fields(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := es.UpdateByQuery(es.Term("status", "draft")).
+		Script(es.ScriptSource("ctx._source.status = params.status", ScriptLanguage.Painless).Parameter("status", "published")).
+		MaxDocs(1000).
+		Conflicts(Conflicts.Proceed).
+		Slice(0, 2)
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, body)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"conflicts\":\"proceed\",\"max_docs\":1000,\"query\":{\"term\":{\"status\":{\"value\":\"draft\"}}},\"script\":{\"lang\":\"painless\",\"params\":{\"status\":\"published\"},\"source\":\"ctx._source.status = params.status\"},\"slice\":{\"id\":0,\"max\":2}}", bodyJSON)
+}
@@ -0,0 +1,1088 @@
+package es
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ValidationError describes a single problem found by es.Validate. Path locates
+// the offending clause in the search body, e.g. "query.bool.filter[2].range.price".
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors is the list of problems returned by es.Validate.
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, 0, len(errs))
+	for i := 0; i < len(errs); i++ {
+		messages = append(messages, errs[i].Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+type clauseValidator func(v *validator, body Object, path string)
+
+var (
+	queryValidators       map[string]clauseValidator
+	aggregationValidators map[string]clauseValidator
+)
+
+func init() {
+	queryValidators = map[string]clauseValidator{
+		"bool":                validateBool,
+		"term":                validateValueClause("value", "missing value"),
+		"terms":               validateTerms,
+		"terms_set":           validateTermsSet,
+		"range":               validateRange,
+		"exists":              validateExists,
+		"ids":                 validateIDs,
+		"prefix":              validateValueClause("value", "missing value"),
+		"wildcard":            validateValueClause("value", "missing value"),
+		"regexp":              validateValueClause("value", "missing value"),
+		"fuzzy":               validateValueClause("value", "missing value"),
+		"match":               validateValueClause("query", "missing query"),
+		"match_phrase":        validateValueClause("query", "missing query"),
+		"match_phrase_prefix": validateValueClause("query", "missing query"),
+		"match_bool_prefix":   validateValueClause("query", "missing query"),
+		"multi_match":         validateTextQuery,
+		"query_string":        validateTextQuery,
+		"simple_query_string": validateTextQuery,
+		"geo_distance":        validateGeoDistance,
+		"geo_bounding_box":    validateGeoBoundingBox,
+		"script":              validateScriptQuery,
+		"nested":              validateNested,
+		"has_child":           validateJoinQuery("type"),
+		"has_parent":          validateJoinQuery("parent_type"),
+		"parent_id":           validateParentID,
+		"constant_score":      validateConstantScore,
+		"dis_max":             validateDisMax,
+		"function_score":      validateFunctionScore,
+		"knn":                 validateKnn,
+		"sparse_vector":       validateSparseVector,
+		"semantic":            validateSemantic,
+		"span_term":           validateValueClause("value", "missing value"),
+		"span_near":           validateSpanClauses,
+		"span_or":             validateSpanClauses,
+		"span_not":            validateSpanChildren("include", "exclude"),
+		"span_first":          validateSpanChildren("match"),
+		"span_containing":     validateSpanChildren("big", "little"),
+		"span_within":         validateSpanChildren("big", "little"),
+		"span_multi":          validateSpanChildren("match"),
+		"field_masking_span":  validateFieldMaskingSpan,
+	}
+	aggregationValidators = map[string]clauseValidator{
+		"terms":          validateTermsAggregation,
+		"multi_terms":    validateMultiTermsAggregation,
+		"histogram":      validateHistogramAggregation,
+		"date_histogram": validateDateHistogramAggregation,
+		"range":          validateRangeAggregation,
+		"date_range":     validateRangeAggregation,
+		"filter":         validateFilterAggregation,
+		"filters":        validateFiltersAggregation,
+		"nested":         validateNestedAggregation,
+		"children":       validateJoinAggregation,
+		"parent":         validateJoinAggregation,
+		"top_hits":       validateTopHitsAggregation,
+		"avg":            validateFieldAggregation,
+		"min":            validateFieldAggregation,
+		"max":            validateFieldAggregation,
+		"sum":            validateFieldAggregation,
+		"stats":          validateFieldAggregation,
+		"extended_stats": validateFieldAggregation,
+		"cardinality":    validateFieldAggregation,
+		"value_count":    validateFieldAggregation,
+	}
+}
+
+// Validate checks a search body built with the es package for mistakes that
+// Elasticsearch would only reject at runtime, such as a range without bounds,
+// a terms query without values, a sort on an empty field, a function_score
+// combining script_score with functions, a histogram with a zero interval or a
+// retriever combined with a query or knn section.
+//
+// Every problem is reported with the path of the offending clause, e.g.
+// "query.bool.filter[2].range.price: no bounds". Clauses the es package does
+// not build are not inspected.
+//
+// Example usage:
+//
+//	query := es.NewQuery(es.Bool().Filter(es.Range("price")))
+//	if errs := es.Validate(query); len(errs) > 0 {
+//		return errs
+//	}
+//	// errs[0].Error() == "query.bool.filter[0].range.price: no bounds"
+//
+// Parameters:
+//   - query: The es.Object search body to validate.
+//
+// Returns:
+//
+//	The es.ValidationErrors found in the body, or nil when it is valid.
+func Validate(query Object) ValidationErrors {
+	v := &validator{}
+	v.validateRoot(query)
+	return v.errors
+}
+
+type validator struct {
+	mapping *IndexMapping
+	errors  ValidationErrors
+}
+
+func (v *validator) report(path, format string, args ...any) {
+	v.errors = append(v.errors, &ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) validateRoot(root Object) {
+	for _, key := range sortedKeys(root) {
+		value := root[key]
+		switch key {
+		case "query", "post_filter":
+			v.validateQuery(value, key)
+		case "aggs", "aggregations":
+			v.validateAggregations(value, key)
+		case "sort":
+			v.validateSorts(value, key)
+		case "highlight":
+			v.validateHighlight(value, key)
+		case "collapse":
+			v.validateCollapse(value, key)
+		case "retriever":
+			for _, legacy := range []string{"knn", "query"} {
+				if _, exists := root[legacy]; exists {
+					v.report(key, "cannot be combined with %q", legacy)
+				}
+			}
+			v.validateRetriever(value, key)
+		case "knn":
+			v.validateKnnSearches(value, key)
+		case "size", "from":
+			v.validateNotNegative(value, key)
+		}
+	}
+}
+
+func (v *validator) validateQuery(value any, path string) {
+	clause, ok := asObject(value)
+	if !ok {
+		v.report(path, "expected a query clause object")
+		return
+	}
+	if len(clause) == 0 {
+		return
+	}
+	if len(clause) > 1 {
+		v.report(path, "expected a single query clause, got %d keys", len(clause))
+		return
+	}
+	for name, body := range clause {
+		validate, exists := queryValidators[name]
+		if !exists {
+			return
+		}
+		object, isObject := asObject(body)
+		if !isObject {
+			v.report(joinPath(path, name), "expected an object")
+			return
+		}
+		validate(v, object, joinPath(path, name))
+		if v.mapping != nil {
+			v.checkQueryMapping(name, object, joinPath(path, name))
+		}
+	}
+}
+
+func (v *validator) validateQueries(value any, path string) {
+	if clauses, ok := asArray(value); ok {
+		for i := 0; i < len(clauses); i++ {
+			v.validateQuery(clauses[i], indexPath(path, i))
+		}
+		return
+	}
+	v.validateQuery(value, path)
+}
+
+func validateBool(v *validator, body Object, path string) {
+	for _, occurrence := range []string{"filter", "must", "must_not", "should"} {
+		if value, exists := body[occurrence]; exists {
+			v.validateQueries(value, joinPath(path, occurrence))
+		}
+	}
+}
+
+// fieldOf returns the single field key of a field level clause such as
+// {"range": {"price": {...}}}, ignoring the given option keys.
+func (v *validator) fieldOf(body Object, path string, options ...string) (string, any, bool) {
+	var fields []string
+	for _, key := range sortedKeys(body) {
+		if !contains(options, key) {
+			fields = append(fields, key)
+		}
+	}
+	if len(fields) != 1 {
+		v.report(path, "expected a single field, got %d", len(fields))
+		return "", nil, false
+	}
+	if fields[0] == "" {
+		v.report(path, "empty field")
+		return "", nil, false
+	}
+	return fields[0], body[fields[0]], true
+}
+
+func validateValueClause(valueKey, message string) clauseValidator {
+	return func(v *validator, body Object, path string) {
+		field, value, ok := v.fieldOf(body, path)
+		if !ok {
+			return
+		}
+		if params, isObject := asObject(value); isObject {
+			value = params[valueKey]
+		}
+		if isNil(value) {
+			v.report(joinPath(path, field), message)
+		}
+	}
+}
+
+func validateTerms(v *validator, body Object, path string) {
+	field, value, ok := v.fieldOf(body, path, "boost", "_name")
+	if !ok {
+		return
+	}
+	if values, isArray := asArray(value); isArray && len(values) == 0 {
+		v.report(joinPath(path, field), "no values")
+	}
+}
+
+func validateTermsSet(v *validator, body Object, path string) {
+	field, value, ok := v.fieldOf(body, path)
+	if !ok {
+		return
+	}
+	params, _ := asObject(value)
+	fieldPath := joinPath(path, field)
+	if values, _ := asArray(params["terms"]); len(values) == 0 {
+		v.report(fieldPath, "no values")
+	}
+	_, hasField := params["minimum_should_match_field"]
+	script, hasScript := params["minimum_should_match_script"]
+	if !hasField && !hasScript {
+		v.report(fieldPath, "missing minimum_should_match_field or minimum_should_match_script")
+	}
+	if hasScript {
+		v.validateScript(script, joinPath(fieldPath, "minimum_should_match_script"))
+	}
+}
+
+func validateRange(v *validator, body Object, path string) {
+	field, value, ok := v.fieldOf(body, path)
+	if !ok {
+		return
+	}
+	params, _ := asObject(value)
+	fieldPath := joinPath(path, field)
+	lower := firstPresent(params, "gt", "gte", "from")
+	upper := firstPresent(params, "lt", "lte", "to")
+	if isNil(lower) && isNil(upper) {
+		v.report(fieldPath, "no bounds")
+		return
+	}
+	from, fromOk := asNumber(lower)
+	to, toOk := asNumber(upper)
+	if fromOk && toOk && from > to {
+		v.report(fieldPath, "lower bound %v is greater than upper bound %v", from, to)
+	}
+}
+
+func validateExists(v *validator, body Object, path string) {
+	if field, _ := body["field"].(string); field == "" {
+		v.report(path, "empty field")
+	}
+}
+
+func validateIDs(v *validator, body Object, path string) {
+	if values, _ := asArray(body["values"]); len(values) == 0 {
+		v.report(path, "no values")
+	}
+}
+
+func validateTextQuery(v *validator, body Object, path string) {
+	if isNil(body["query"]) {
+		v.report(path, "missing query")
+	}
+}
+
+func validateGeoDistance(v *validator, body Object, path string) {
+	field, value, ok := v.fieldOf(body, path, "distance", "distance_type", "validation_method", "ignore_unmapped", "boost", "_name")
+	if isEmptyString(body["distance"]) {
+		v.report(path, "missing distance")
+	}
+	if ok {
+		v.validateGeoPoint(value, joinPath(path, field))
+	}
+}
+
+func validateGeoBoundingBox(v *validator, body Object, path string) {
+	field, value, ok := v.fieldOf(body, path, "validation_method", "ignore_unmapped", "boost", "_name")
+	if !ok {
+		return
+	}
+	fieldPath := joinPath(path, field)
+	box, _ := asObject(value)
+	topLeft, hasTopLeft := box["top_left"]
+	bottomRight, hasBottomRight := box["bottom_right"]
+	if !hasTopLeft || !hasBottomRight {
+		v.report(fieldPath, "missing top_left or bottom_right")
+		return
+	}
+	v.validateGeoPoint(topLeft, joinPath(fieldPath, "top_left"))
+	v.validateGeoPoint(bottomRight, joinPath(fieldPath, "bottom_right"))
+	top, topOk := asNumber(asObjectOrEmpty(topLeft)["lat"])
+	bottom, bottomOk := asNumber(asObjectOrEmpty(bottomRight)["lat"])
+	if topOk && bottomOk && top < bottom {
+		v.report(fieldPath, "top_left latitude %v is below bottom_right latitude %v", top, bottom)
+	}
+}
+
+// validateGeoPoint checks the latitude and longitude of {"lat": .., "lon": ..}
+// points; other geo point formats are not inspected.
+func (v *validator) validateGeoPoint(value any, path string) {
+	point, ok := asObject(value)
+	if !ok {
+		return
+	}
+	if lat, isNumber := asNumber(point["lat"]); isNumber && (lat < -90 || lat > 90) {
+		v.report(path, "latitude %v is out of range [-90, 90]", lat)
+	}
+	if lon, isNumber := asNumber(point["lon"]); isNumber && (lon < -180 || lon > 180) {
+		v.report(path, "longitude %v is out of range [-180, 180]", lon)
+	}
+}
+
+func validateScriptQuery(v *validator, body Object, path string) {
+	v.validateScript(body["script"], joinPath(path, "script"))
+}
+
+func (v *validator) validateScript(value any, path string) {
+	if source, isString := value.(string); isString {
+		if source == "" {
+			v.report(path, "missing source or id")
+		}
+		return
+	}
+	script, ok := asObject(value)
+	if !ok {
+		v.report(path, "missing source or id")
+		return
+	}
+	source, hasSource := script["source"]
+	id, hasID := script["id"]
+	switch {
+	case hasSource && hasID:
+		v.report(path, "source and id are mutually exclusive")
+	case isEmptyString(source) && isEmptyString(id):
+		v.report(path, "missing source or id")
+	}
+}
+
+func validateNested(v *validator, body Object, path string) {
+	if nestedPath, _ := body["path"].(string); nestedPath == "" {
+		v.report(path, "empty path")
+	}
+	if query, exists := body["query"]; exists {
+		v.validateQuery(query, joinPath(path, "query"))
+	} else {
+		v.report(path, "missing query")
+	}
+	if innerHits, exists := body["inner_hits"]; exists {
+		v.validateInnerHits(innerHits, joinPath(path, "inner_hits"))
+	}
+}
+
+func validateJoinQuery(typeKey string) clauseValidator {
+	return func(v *validator, body Object, path string) {
+		if isEmptyString(body[typeKey]) {
+			v.report(path, "empty %s", typeKey)
+		}
+		if query, exists := body["query"]; exists {
+			v.validateQuery(query, joinPath(path, "query"))
+		} else {
+			v.report(path, "missing query")
+		}
+		if innerHits, exists := body["inner_hits"]; exists {
+			v.validateInnerHits(innerHits, joinPath(path, "inner_hits"))
+		}
+		minChildren, hasMin := asNumber(body["min_children"])
+		maxChildren, hasMax := asNumber(body["max_children"])
+		if hasMin && hasMax && minChildren > maxChildren {
+			v.report(path, "min_children is greater than max_children")
+		}
+	}
+}
+
+func validateParentID(v *validator, body Object, path string) {
+	if isEmptyString(body["type"]) {
+		v.report(path, "empty type")
+	}
+	if isEmptyString(body["id"]) {
+		v.report(path, "empty id")
+	}
+}
+
+// validateKnnSearches validates the top-level "knn" section, which holds a
+// single knn search or an array of them.
+func (v *validator) validateKnnSearches(value any, path string) {
+	if searches, isArray := asArray(value); isArray {
+		for i := 0; i < len(searches); i++ {
+			validateKnn(v, asObjectOrEmpty(searches[i]), indexPath(path, i))
+		}
+		return
+	}
+	validateKnn(v, asObjectOrEmpty(value), path)
+}
+
+func validateKnn(v *validator, body Object, path string) {
+	v.validateKnnSearch(body, path)
+	if filter, exists := body["filter"]; exists {
+		v.validateQueries(filter, joinPath(path, "filter"))
+	}
+	if innerHits, exists := body["inner_hits"]; exists {
+		v.validateInnerHits(innerHits, joinPath(path, "inner_hits"))
+	}
+}
+
+func (v *validator) validateKnnSearch(body Object, path string) {
+	if isEmptyString(body["field"]) {
+		v.report(path, "empty field")
+	}
+	if isNil(body["query_vector"]) && isNil(body["query_vector_builder"]) {
+		v.report(path, "missing query_vector or query_vector_builder")
+	}
+	k, hasK := asNumber(body["k"])
+	numCandidates, hasNumCandidates := asNumber(body["num_candidates"])
+	if hasK && hasNumCandidates && k > numCandidates {
+		v.report(path, "k is greater than num_candidates")
+	}
+}
+
+func validateSparseVector(v *validator, body Object, path string) {
+	if isEmptyString(body["field"]) {
+		v.report(path, "empty field")
+	}
+	_, hasInferenceID := body["inference_id"]
+	_, hasQueryVector := body["query_vector"]
+	switch {
+	case hasInferenceID && hasQueryVector:
+		v.report(path, "inference_id and query_vector are mutually exclusive")
+	case !hasInferenceID && !hasQueryVector:
+		v.report(path, "missing inference_id or query_vector")
+	case hasInferenceID && isEmptyString(body["query"]):
+		v.report(path, "missing query")
+	}
+}
+
+func validateSemantic(v *validator, body Object, path string) {
+	if isEmptyString(body["field"]) {
+		v.report(path, "empty field")
+	}
+	if isEmptyString(body["query"]) {
+		v.report(path, "missing query")
+	}
+}
+
+func validateSpanClauses(v *validator, body Object, path string) {
+	clauses, _ := asArray(body["clauses"])
+	if len(clauses) == 0 {
+		v.report(path, "no clauses")
+		return
+	}
+	v.validateQueries(clauses, joinPath(path, "clauses"))
+}
+
+// validateSpanChildren validates span queries such as span_not, whose required
+// keys each hold a single child query.
+func validateSpanChildren(keys ...string) clauseValidator {
+	return func(v *validator, body Object, path string) {
+		for _, key := range keys {
+			child, exists := body[key]
+			if !exists {
+				v.report(path, "missing %s", key)
+				continue
+			}
+			v.validateQuery(child, joinPath(path, key))
+		}
+	}
+}
+
+func validateFieldMaskingSpan(v *validator, body Object, path string) {
+	if isEmptyString(body["field"]) {
+		v.report(path, "empty field")
+	}
+	validateSpanChildren("query")(v, body, path)
+}
+
+func (v *validator) validateInnerHits(value any, path string) {
+	innerHits, ok := asObject(value)
+	if !ok {
+		return
+	}
+	v.validateNotNegative(innerHits["size"], joinPath(path, "size"))
+	v.validateNotNegative(innerHits["from"], joinPath(path, "from"))
+	if sorts, exists := innerHits["sort"]; exists {
+		v.validateSorts(sorts, joinPath(path, "sort"))
+	}
+	if collapse, exists := innerHits["collapse"]; exists {
+		v.validateCollapse(collapse, joinPath(path, "collapse"))
+	}
+}
+
+func validateConstantScore(v *validator, body Object, path string) {
+	filter, exists := body["filter"]
+	if !exists {
+		v.report(path, "missing filter")
+		return
+	}
+	v.validateQuery(filter, joinPath(path, "filter"))
+}
+
+func validateDisMax(v *validator, body Object, path string) {
+	queries, _ := asArray(body["queries"])
+	if len(queries) == 0 {
+		v.report(path, "no queries")
+		return
+	}
+	v.validateQueries(queries, joinPath(path, "queries"))
+}
+
+// scoreFunctions are the keys that define how a function_score function or
+// the function_score query itself computes a score.
+var scoreFunctions = []string{"script_score", "random_score", "field_value_factor", "weight", "gauss", "linear", "exp"}
+
+func validateFunctionScore(v *validator, body Object, path string) {
+	if query, exists := body["query"]; exists {
+		v.validateQuery(query, joinPath(path, "query"))
+	}
+	functions, hasFunctions := body["functions"]
+	for _, key := range scoreFunctions {
+		if _, exists := body[key]; exists && hasFunctions {
+			v.report(path, "%s cannot be combined with functions", key)
+		}
+	}
+	v.validateScoreFunction(body, path, false)
+	if !hasFunctions {
+		return
+	}
+	items, _ := asArray(functions)
+	for i := 0; i < len(items); i++ {
+		function, _ := asObject(items[i])
+		v.validateScoreFunction(function, joinPath(path, indexPath("functions", i)), true)
+	}
+}
+
+// validateScoreFunction checks the score function keys of body. When required
+// is set, body must define at least one of them.
+func (v *validator) validateScoreFunction(body Object, path string, required bool) {
+	found := false
+	for _, key := range scoreFunctions {
+		value, exists := body[key]
+		if !exists {
+			continue
+		}
+		found = true
+		keyPath := joinPath(path, key)
+		switch key {
+		case "script_score":
+			scriptScore, _ := asObject(value)
+			v.validateScript(scriptScore["script"], joinPath(keyPath, "script"))
+		case "field_value_factor":
+			fieldValueFactor, _ := asObject(value)
+			if field, _ := fieldValueFactor["field"].(string); field == "" {
+				v.report(keyPath, "empty field")
+			}
+		case "gauss", "linear", "exp":
+			v.validateDecay(value, keyPath)
+		}
+	}
+	if required && !found {
+		v.report(path, "missing score function")
+	}
+	if filter, exists := body["filter"]; exists && required {
+		v.validateQuery(filter, joinPath(path, "filter"))
+	}
+}
+
+func (v *validator) validateDecay(value any, path string) {
+	decay, _ := asObject(value)
+	field, params, ok := v.fieldOf(decay, path, "multi_value_mode")
+	if !ok {
+		return
+	}
+	if isNil(asObjectOrEmpty(params)["scale"]) {
+		v.report(joinPath(path, field), "missing scale")
+	}
+}
+
+func (v *validator) validateSorts(value any, path string) {
+	sorts, ok := asArray(value)
+	if !ok {
+		sorts = Array{value}
+	}
+	for i := 0; i < len(sorts); i++ {
+		v.validateSort(sorts[i], indexPath(path, i))
+	}
+}
+
+func (v *validator) validateSort(value any, path string) {
+	if field, isString := value.(string); isString {
+		if field == "" {
+			v.report(path, "empty field")
+		} else if v.mapping != nil {
+			v.checkSortMapping(field, nil, path)
+		}
+		return
+	}
+	sort, ok := asObject(value)
+	if !ok || len(sort) != 1 {
+		v.report(path, "expected a single sort field")
+		return
+	}
+	for field, params := range sort {
+		if field == "" {
+			v.report(path, "empty field")
+			return
+		}
+		if nested, exists := asObjectOrEmpty(params)["nested"]; exists {
+			v.validateNestedSort(nested, joinPath(joinPath(path, field), "nested"))
+		}
+		if v.mapping != nil {
+			v.checkSortMapping(field, asObjectOrEmpty(params), joinPath(path, field))
+		}
+	}
+}
+
+func (v *validator) validateNestedSort(value any, path string) {
+	nested, _ := asObject(value)
+	if nestedPath, _ := nested["path"].(string); nestedPath == "" {
+		v.report(path, "empty path")
+	} else if v.mapping != nil {
+		v.checkNestedPath(nestedPath, path)
+	}
+	if filter, exists := nested["filter"]; exists {
+		v.validateQuery(filter, joinPath(path, "filter"))
+	}
+	if inner, exists := nested["nested"]; exists {
+		v.validateNestedSort(inner, joinPath(path, "nested"))
+	}
+}
+
+func (v *validator) validateHighlight(value any, path string) {
+	highlight, _ := asObject(value)
+	fields, _ := asObject(highlight["fields"])
+	if len(fields) == 0 {
+		v.report(path, "no fields")
+	}
+	if query, exists := highlight["highlight_query"]; exists {
+		v.validateQuery(query, joinPath(path, "highlight_query"))
+	}
+	for _, field := range sortedKeys(fields) {
+		fieldPath := joinPath(joinPath(path, "fields"), field)
+		if field == "" {
+			v.report(fieldPath, "empty field")
+		}
+		if query, exists := asObjectOrEmpty(fields[field])["highlight_query"]; exists {
+			v.validateQuery(query, joinPath(fieldPath, "highlight_query"))
+		}
+	}
+}
+
+func (v *validator) validateCollapse(value any, path string) {
+	collapse, _ := asObject(value)
+	if field, _ := collapse["field"].(string); field == "" {
+		v.report(path, "empty field")
+	}
+	if innerHits, exists := collapse["inner_hits"]; exists {
+		items, isArray := asArray(innerHits)
+		if !isArray {
+			v.validateInnerHits(innerHits, joinPath(path, "inner_hits"))
+		}
+		for i := 0; i < len(items); i++ {
+			v.validateInnerHits(items[i], indexPath(joinPath(path, "inner_hits"), i))
+		}
+	}
+	if _, exists := collapse["collapse"]; exists {
+		v.report(joinPath(path, "collapse"), "a second level collapse is only allowed inside inner_hits")
+	}
+}
+
+func (v *validator) validateRetriever(value any, path string) {
+	retriever, ok := asObject(value)
+	if !ok {
+		v.report(path, "expected a retriever object")
+		return
+	}
+	if len(retriever) != 1 {
+		v.report(path, "expected a single retriever, got %d keys", len(retriever))
+		return
+	}
+	for name, rawBody := range retriever {
+		body := asObjectOrEmpty(rawBody)
+		retrieverPath := joinPath(path, name)
+		if filter, exists := body["filter"]; exists {
+			v.validateQueries(filter, joinPath(retrieverPath, "filter"))
+		}
+		switch name {
+		case "standard":
+			if query, exists := body["query"]; exists {
+				v.validateQuery(query, joinPath(retrieverPath, "query"))
+			}
+			if sorts, exists := body["sort"]; exists {
+				v.validateSorts(sorts, joinPath(retrieverPath, "sort"))
+			}
+			if collapse, exists := body["collapse"]; exists {
+				v.validateCollapse(collapse, joinPath(retrieverPath, "collapse"))
+			}
+		case "knn":
+			v.validateKnnSearch(body, retrieverPath)
+			for _, required := range []string{"k", "num_candidates"} {
+				if isNil(body[required]) {
+					v.report(retrieverPath, "missing %s", required)
+				}
+			}
+		case "rrf":
+			children, _ := asArray(body["retrievers"])
+			if len(children) == 0 {
+				v.report(retrieverPath, "missing retrievers")
+			}
+			for i := 0; i < len(children); i++ {
+				v.validateRetriever(children[i], indexPath(joinPath(retrieverPath, "retrievers"), i))
+			}
+		case "rule", "text_similarity_reranker":
+			child, exists := body["retriever"]
+			if !exists {
+				v.report(retrieverPath, "missing retriever")
+				continue
+			}
+			v.validateRetriever(child, joinPath(retrieverPath, "retriever"))
+		}
+	}
+}
+
+func (v *validator) validateNotNegative(value any, path string) {
+	if number, ok := asNumber(value); ok && number < 0 {
+		v.report(path, "must not be negative")
+	}
+}
+
+func (v *validator) validateAggregations(value any, path string) {
+	aggs, ok := asObject(value)
+	if !ok {
+		v.report(path, "expected an object")
+		return
+	}
+	for _, name := range sortedKeys(aggs) {
+		if name == "" {
+			v.report(path, "empty aggregation name")
+			continue
+		}
+		v.validateAggregation(aggs[name], joinPath(path, name))
+	}
+}
+
+func (v *validator) validateAggregation(value any, path string) {
+	agg, ok := asObject(value)
+	if !ok {
+		v.report(path, "expected an object")
+		return
+	}
+	var types []string
+	for _, key := range sortedKeys(agg) {
+		switch key {
+		case "aggs", "aggregations":
+			v.validateAggregations(agg[key], joinPath(path, key))
+		case "meta":
+		default:
+			types = append(types, key)
+		}
+	}
+	if len(types) != 1 {
+		if len(types) == 0 {
+			v.report(path, "missing aggregation type")
+		} else {
+			v.report(path, "expected a single aggregation type, got %d", len(types))
+		}
+		return
+	}
+	if validate, exists := aggregationValidators[types[0]]; exists {
+		body, _ := asObject(agg[types[0]])
+		validate(v, body, joinPath(path, types[0]))
+		if v.mapping != nil {
+			v.checkAggregationMapping(types[0], body, joinPath(path, types[0]))
+		}
+	}
+}
+
+func validateJoinAggregation(v *validator, body Object, path string) {
+	if isEmptyString(body["type"]) {
+		v.report(path, "empty type")
+	}
+}
+
+func validateFieldAggregation(v *validator, body Object, path string) {
+	field, hasField := body["field"]
+	script, hasScript := body["script"]
+	switch {
+	case !hasField && !hasScript:
+		v.report(path, "missing field or script")
+	case hasField && isEmptyString(field):
+		v.report(path, "empty field")
+	}
+	if hasScript {
+		v.validateScript(script, joinPath(path, "script"))
+	}
+}
+
+func validateTermsAggregation(v *validator, body Object, path string) {
+	validateFieldAggregation(v, body, path)
+	if size, ok := asNumber(body["size"]); ok && size <= 0 {
+		v.report(joinPath(path, "size"), "must be greater than zero")
+	}
+	v.validateAggregationOrder(body["order"], joinPath(path, "order"))
+}
+
+func (v *validator) validateAggregationOrder(value any, path string) {
+	orders, ok := asArray(value)
+	if !ok {
+		if isNil(value) {
+			return
+		}
+		orders = Array{value}
+	}
+	for i := 0; i < len(orders); i++ {
+		order, _ := asObject(orders[i])
+		for _, key := range sortedKeys(order) {
+			if key == "" {
+				v.report(indexPath(path, i), "empty key")
+			}
+		}
+	}
+}
+
+func validateMultiTermsAggregation(v *validator, body Object, path string) {
+	terms, _ := asArray(body["terms"])
+	if len(terms) < 2 {
+		v.report(path, "requires at least two terms, got %d", len(terms))
+	}
+	for i := 0; i < len(terms); i++ {
+		term, _ := asObject(terms[i])
+		if field, _ := term["field"].(string); field == "" {
+			v.report(indexPath(joinPath(path, "terms"), i), "empty field")
+		}
+	}
+	if size, ok := asNumber(body["size"]); ok && size <= 0 {
+		v.report(joinPath(path, "size"), "must be greater than zero")
+	}
+	v.validateAggregationOrder(body["order"], joinPath(path, "order"))
+}
+
+func validateHistogramAggregation(v *validator, body Object, path string) {
+	validateFieldAggregation(v, body, path)
+	if interval, ok := asNumber(body["interval"]); !ok || interval <= 0 {
+		v.report(joinPath(path, "interval"), "must be greater than zero")
+	}
+	v.validateAggregationOrder(body["order"], joinPath(path, "order"))
+}
+
+func validateDateHistogramAggregation(v *validator, body Object, path string) {
+	validateFieldAggregation(v, body, path)
+	calendar, hasCalendar := body["calendar_interval"]
+	fixed, hasFixed := body["fixed_interval"]
+	switch {
+	case hasCalendar && hasFixed:
+		v.report(path, "calendar_interval and fixed_interval are mutually exclusive")
+	case isEmptyString(calendar) && isEmptyString(fixed):
+		v.report(path, "missing calendar_interval or fixed_interval")
+	}
+	v.validateAggregationOrder(body["order"], joinPath(path, "order"))
+}
+
+func validateRangeAggregation(v *validator, body Object, path string) {
+	validateFieldAggregation(v, body, path)
+	ranges, _ := asArray(body["ranges"])
+	if len(ranges) == 0 {
+		v.report(path, "no ranges")
+	}
+	for i := 0; i < len(ranges); i++ {
+		entry, _ := asObject(ranges[i])
+		if isNil(entry["from"]) && isNil(entry["to"]) {
+			v.report(indexPath(joinPath(path, "ranges"), i), "no bounds")
+		}
+	}
+}
+
+func validateFilterAggregation(v *validator, body Object, path string) {
+	v.validateQuery(body, path)
+}
+
+func validateFiltersAggregation(v *validator, body Object, path string) {
+	filtersPath := joinPath(path, "filters")
+	if filters, isArray := asArray(body["filters"]); isArray {
+		if len(filters) == 0 {
+			v.report(path, "no filters")
+		}
+		v.validateQueries(filters, filtersPath)
+		return
+	}
+	filters, _ := asObject(body["filters"])
+	if len(filters) == 0 {
+		v.report(path, "no filters")
+	}
+	for _, name := range sortedKeys(filters) {
+		v.validateQuery(filters[name], joinPath(filtersPath, name))
+	}
+}
+
+func validateNestedAggregation(v *validator, body Object, path string) {
+	if nestedPath, _ := body["path"].(string); nestedPath == "" {
+		v.report(path, "empty path")
+	}
+}
+
+func validateTopHitsAggregation(v *validator, body Object, path string) {
+	v.validateNotNegative(body["size"], joinPath(path, "size"))
+	v.validateNotNegative(body["from"], joinPath(path, "from"))
+	if sorts, exists := body["sort"]; exists {
+		v.validateSorts(sorts, joinPath(path, "sort"))
+	}
+}
+
+var objectType = reflect.TypeOf(Object{})
+
+// asObject converts any map keyed by strings, such as the builder types of the
+// es package, into an es.Object.
+func asObject(value any) (Object, bool) {
+	if object, ok := value.(Object); ok {
+		return object, true
+	}
+	if value == nil {
+		return nil, false
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	if rv.Type().ConvertibleTo(objectType) {
+		return rv.Convert(objectType).Interface().(Object), true
+	}
+	object := make(Object, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		object[iter.Key().String()] = iter.Value().Interface()
+	}
+	return object, true
+}
+
+func asObjectOrEmpty(value any) Object {
+	object, _ := asObject(value)
+	return object
+}
+
+// asArray converts any slice, such as es.FilterType or []es.sortType, into an
+// es.Array.
+func asArray(value any) (Array, bool) {
+	if array, ok := value.(Array); ok {
+		return array, true
+	}
+	if value == nil {
+		return nil, false
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	array := make(Array, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		array = append(array, rv.Index(i).Interface())
+	}
+	return array, true
+}
+
+func asNumber(value any) (float64, bool) {
+	if number, ok := value.(json.Number); ok {
+		f, err := number.Float64()
+		return f, err == nil
+	}
+	if value == nil {
+		return 0, false
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+func isEmptyString(value any) bool {
+	s, ok := value.(string)
+	return isNil(value) || (ok && s == "")
+}
+
+func firstPresent(object Object, keys ...string) any {
+	for _, key := range keys {
+		if !isNil(object[key]) {
+			return object[key]
+		}
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for i := 0; i < len(values); i++ {
+		if values[i] == value {
+			return true
+		}
+	}
+	return false
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func indexPath(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
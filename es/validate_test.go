@@ -0,0 +1,413 @@
+package es_test
+
+import (
+	"errors"
+	"testing"
+
+	ScriptLanguage "github.com/Trendyol/es-query-builder/es/enums/script-language"
+	Order "github.com/Trendyol/es-query-builder/es/enums/sort/order"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   Validate   ////
+
+func validationMessages(errs es.ValidationErrors) []string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return messages
+}
+
+func Test_Validate_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.Validate)
+}
+
+func Test_Validate_should_return_nil_for_valid_query(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(
+		es.Bool().
+			Filter(
+				es.Term("brand", "apple"),
+				es.Terms("color", "red", "blue"),
+				es.Range("price").GreaterThanOrEqual(10).LessThan(100),
+				es.Exists("stock"),
+				es.GeoDistance("location", 41.0, 29.0, "10km"),
+				es.Nested("variants", es.Match("variants.name", "pro")).InnerHits(es.InnerHits().Size(3)),
+			).
+			Must(es.FunctionScore(es.MatchAll()).Functions(es.WeightFunction(2), es.ScriptScoreFunction(
+				es.ScriptSource("_score * 2", ScriptLanguage.Painless),
+			))),
+	).
+		Size(10).
+		Sort(es.Sort("date").Order(Order.Desc)).
+		Aggs(
+			es.Agg("by_brand", es.TermsAgg("brand").Size(10).Aggs(es.Agg("avg_price", es.AvgAgg("price")))),
+			es.Agg("prices", es.HistogramAgg("price", 50)),
+			es.Agg("per_day", es.DateHistogramAgg("date").CalendarInterval("day")),
+			es.Agg("ranges", es.RangeAgg("price").Range(es.RangeEntry().To(50))),
+		)
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.True(t, errs == nil)
+}
+
+func Test_Validate_should_report_range_without_bounds_with_its_path(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Filter(es.Term("a", "b"), es.Exists("c"), es.Range("price")))
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "query.bool.filter[2].range.price", errs[0].Path)
+	assert.Equal(t, "no bounds", errs[0].Message)
+	assert.Equal(t, "query.bool.filter[2].range.price: no bounds", errs[0].Error())
+}
+
+func Test_Validate_should_report_range_with_inverted_bounds(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Range("price").GreaterThan(100).LessThan(10))
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{"query.range.price: lower bound 100 is greater than upper bound 10"}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_field_level_query_mistakes(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().
+		Filter(
+			es.Terms[string]("color"),
+			es.Term("", "x"),
+			es.Term[any]("brand", nil),
+			es.Exists(""),
+			es.IDs[string](),
+			es.TermsSet("tags", "a"),
+		).
+		Must(
+			es.Match[any]("title", nil),
+			es.MultiMatch[any](nil),
+			es.GeoDistance("location", 91, 29, ""),
+		),
+	)
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"query.bool.filter[0].terms.color: no values",
+		"query.bool.filter[1].term: empty field",
+		"query.bool.filter[2].term.brand: missing value",
+		"query.bool.filter[3].exists: empty field",
+		"query.bool.filter[4].ids: no values",
+		"query.bool.filter[5].terms_set.tags: missing minimum_should_match_field or minimum_should_match_script",
+		"query.bool.must[0].match.title: missing query",
+		"query.bool.must[1].multi_match: missing query",
+		"query.bool.must[2].geo_distance: missing distance",
+		"query.bool.must[2].geo_distance.location: latitude 91 is out of range [-90, 90]",
+	}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_compound_query_mistakes(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Should(
+		es.Nested("", es.Range("variants.price")),
+		es.DisMax(),
+		es.ScriptQuery(es.ScriptSource("", ScriptLanguage.Painless)),
+		es.GeoBoundingBox("location", 10, 0, 20, 5),
+	))
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"query.bool.should[0].nested: empty path",
+		"query.bool.should[0].nested.query.range.variants.price: no bounds",
+		"query.bool.should[1].dis_max: no queries",
+		"query.bool.should[2].script.script: missing source or id",
+		"query.bool.should[3].geo_bounding_box.location: top_left latitude 10 is below bottom_right latitude 20",
+	}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_function_score_with_script_score_and_functions(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.FunctionScore(es.MatchAll()).
+		ScriptScore(es.ScriptSource("_score", ScriptLanguage.Painless)).
+		Functions(
+			es.WeightFunction(2),
+			es.FieldValueFactorFunction(es.FieldValueFactor("")),
+			es.DecayFunction("gauss", es.Decay("date").Origin("now")),
+		))
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"query.function_score: script_score cannot be combined with functions",
+		"query.function_score.functions[1].field_value_factor: empty field",
+		"query.function_score.functions[2].gauss.date: missing scale",
+	}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_sort_on_empty_field(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.MatchAll()).Sort(
+		es.Sort("date"),
+		es.Sort(""),
+		es.Sort("price").Nested(es.NestedSort("").Filter(es.Terms[string]("a"))),
+	)
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"sort[1]: empty field",
+		"sort[2].price.nested: empty path",
+		"sort[2].price.nested.filter.terms.a: no values",
+	}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_root_mistakes(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Term("a", "b")).
+		Size(-1).
+		From(-5).
+		Highlight(es.Highlight()).
+		PostFilter(es.Range("price"))
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"from: must not be negative",
+		"highlight: no fields",
+		"post_filter.range.price: no bounds",
+		"size: must not be negative",
+	}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_second_level_collapse_outside_inner_hits(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(nil).Collapse(
+		es.FieldCollapse("seller_id").
+			InnerHits(es.InnerHits().Name("by_brand").Collapse(es.FieldCollapse(""))).
+			Collapse(es.FieldCollapse("seller_city").Collapse(es.FieldCollapse(""))),
+	)
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"collapse.inner_hits[0].collapse: empty field",
+		"collapse.collapse: a second level collapse is only allowed inside inner_hits",
+	}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_histogram_with_zero_interval(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewAggs(es.Agg("prices", es.HistogramAgg("price", 0)))
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{"aggs.prices.histogram.interval: must be greater than zero"}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_aggregation_mistakes(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewAggs(
+		es.Agg("brands", es.TermsAgg("brand").Size(0).Aggs(
+			es.Agg("avg", es.AvgAgg("")),
+			es.Agg("top", es.TopHitsAgg().Size(-1).Sort(es.Sort(""))),
+		)),
+		es.Agg("days", es.DateHistogramAgg("date")),
+		es.Agg("ranges", es.RangeAgg("price").Range(es.RangeEntry().Key("all"))),
+		es.Agg("dates", es.DateRangeAgg("date")),
+		es.Agg("pairs", es.MultiTermsAgg(es.TermAgg("a"))),
+		es.Agg("active", es.FilterAgg(es.Range("price"))),
+		es.Agg("kinds", es.FiltersAgg()),
+		es.Agg("variants", es.NestedAgg("")),
+		es.Agg("empty", es.Object{"meta": es.Object{"a": 1}}),
+	)
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"aggs.active.filter.range.price: no bounds",
+		"aggs.brands.aggs.avg.avg: empty field",
+		"aggs.brands.aggs.top.top_hits.size: must not be negative",
+		"aggs.brands.aggs.top.top_hits.sort[0]: empty field",
+		"aggs.brands.terms.size: must be greater than zero",
+		"aggs.dates.date_range: no ranges",
+		"aggs.days.date_histogram: missing calendar_interval or fixed_interval",
+		"aggs.empty: missing aggregation type",
+		"aggs.kinds.filters: no filters",
+		"aggs.pairs.multi_terms: requires at least two terms, got 1",
+		"aggs.ranges.range.ranges[0]: no bounds",
+		"aggs.variants.nested: empty path",
+	}, validationMessages(errs))
+}
+
+func Test_Validate_should_validate_parsed_queries(t *testing.T) {
+	t.Parallel()
+	// Given
+	body := `{"query":{"bool":{"filter":{"range":{"price":{}}}}},"aggs":{"h":{"histogram":{"field":"price","interval":0}}}}`
+	query, err := es.ParseQuery([]byte(body))
+	assert.Nil(t, err)
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"aggs.h.histogram.interval: must be greater than zero",
+		"query.bool.filter[0].range.price: no bounds",
+	}, validationMessages(errs))
+}
+
+func Test_ValidationErrors_should_implement_error(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Range("a")).Sort(es.Sort(""))
+
+	// When
+	var err error = es.Validate(query)
+
+	// Then
+	assert.Equal(t, "query.range.a: no bounds; sort[0]: empty field", err.Error())
+	var validationErrors es.ValidationErrors
+	assert.True(t, errors.As(err, &validationErrors))
+	assert.Equal(t, 2, len(validationErrors))
+}
+
+func Test_Validate_should_report_parent_join_mistakes(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().
+		Should(es.HasChild("", es.Range("price")).MinChildren(5).MaxChildren(2)).
+		Should(es.HasParent[any]("seller", nil)).
+		Should(es.ParentID("listing", ""))).
+		Aggs(es.Agg("to_sellers", es.ParentAgg("")))
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"aggs.to_sellers.parent: empty type",
+		"query.bool.should[0].has_child: empty type",
+		"query.bool.should[0].has_child.query.range.price: no bounds",
+		"query.bool.should[0].has_child: min_children is greater than max_children",
+		"query.bool.should[2].parent_id: empty id",
+	}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_knn_mistakes(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Should(
+		es.Knn("").QueryVector(0.1, 0.2).K(10).NumCandidates(100),
+		es.Knn("title_embedding").K(10).NumCandidates(5).Filter(es.Range("price")),
+	)).Knn(es.Knn("image_embedding").K(5))
+
+	// When
+	errs := es.Validate(query)
+
+	// Then
+	assert.Equal(t, []string{
+		"knn: missing query_vector or query_vector_builder",
+		"query.bool.should[0].knn: empty field",
+		"query.bool.should[1].knn: missing query_vector or query_vector_builder",
+		"query.bool.should[1].knn: k is greater than num_candidates",
+		"query.bool.should[1].knn.filter[0].range.price: no bounds",
+	}, validationMessages(errs))
+}
+
+func Test_Validate_should_report_sparse_vector_and_semantic_mistakes(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Should(
+		es.SparseVector("content_embedding"),
+		es.SparseVector("").InferenceID("my-elser-endpoint"),
+		es.Semantic("", ""),
+	))
+	rawQuery := es.Object{"query": es.Object{"sparse_vector": es.Object{
+		"field":        "content_embedding",
+		"inference_id": "my-elser-endpoint",
+		"query":        "running shoes",
+		"query_vector": es.Object{"shoe": 1.2},
+	}}}
+
+	// When
+	errs := es.Validate(query)
+	rawErrs := es.Validate(rawQuery)
+
+	// Then
+	assert.Equal(t, []string{
+		"query.bool.should[0].sparse_vector: missing inference_id or query_vector",
+		"query.bool.should[1].sparse_vector: empty field",
+		"query.bool.should[1].sparse_vector: missing query",
+		"query.bool.should[2].semantic: empty field",
+		"query.bool.should[2].semantic: missing query",
+	}, validationMessages(errs))
+	assert.Equal(t, []string{
+		"query.sparse_vector: inference_id and query_vector are mutually exclusive",
+	}, validationMessages(rawErrs))
+}
+
+func Test_Validate_should_report_span_query_mistakes(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Should(
+		es.SpanNear(),
+		es.SpanOr(es.SpanTerm("", "indemnify"), es.SpanNear(es.SpanTerm("body", "a"))),
+		es.SpanNot(es.SpanTerm("body", "a"), es.SpanOr()),
+		es.FieldMaskingSpan(es.SpanMulti(es.Range("price")), ""),
+	))
+	rawQuery := es.Object{"query": es.Object{"span_first": es.Object{"end": 3}}}
+
+	// When
+	errs := es.Validate(query)
+	rawErrs := es.Validate(rawQuery)
+
+	// Then
+	assert.Equal(t, []string{
+		"query.bool.should[0].span_near: no clauses",
+		"query.bool.should[1].span_or.clauses[0].span_term: empty field",
+		"query.bool.should[2].span_not.exclude.span_or: no clauses",
+		"query.bool.should[3].field_masking_span: empty field",
+		"query.bool.should[3].field_masking_span.query.span_multi.match.range.price: no bounds",
+	}, validationMessages(errs))
+	assert.Equal(t, []string{"query.span_first: missing match"}, validationMessages(rawErrs))
+}
@@ -0,0 +1,61 @@
+package es
+
+type weightedTokensType Object
+
+// WeightedTokens creates a new es.weightedTokensType object for a weighted_tokens
+// query, which searches a sparse_vector or rank_features field with tokens that
+// were expanded beforehand.
+//
+// The weighted_tokens query is deprecated in newer Elasticsearch versions in
+// favour of es.SparseVector with QueryVector, and is kept for clusters that still use it.
+//
+// Example usage:
+//
+//	w := es.WeightedTokens("content_embedding", map[string]float64{"shoe": 1.2, "running": 0.8})
+//	// w now contains {"weighted_tokens": {"content_embedding": {"tokens": {"running": 0.8, "shoe": 1.2}}}}
+//
+// Parameters:
+//   - field: The name of the field holding the tokens.
+//   - tokens: The tokens mapped to their weights.
+//
+// Returns:
+//
+//	An es.weightedTokensType object containing the weighted_tokens query.
+func WeightedTokens(field string, tokens map[string]float64) weightedTokensType {
+	return weightedTokensType{
+		"weighted_tokens": Object{
+			field: Object{
+				"tokens": tokens,
+			},
+		},
+	}
+}
+
+// PruningConfig sets the thresholds used to drop frequent and low weight tokens
+// from the query.
+//
+// Parameters:
+//   - pruningConfig: An es.pruningConfigType created with es.PruningConfig.
+//
+// Returns:
+//
+//	The updated es.weightedTokensType object with the "pruning_config" field set.
+func (wt weightedTokensType) PruningConfig(pruningConfig pruningConfigType) weightedTokensType {
+	return wt.putInTheField("pruning_config", pruningConfig)
+}
+
+// Boost sets the "boost" field in the weighted_tokens query.
+//
+// Parameters:
+//   - boost: A float64 value representing the boost factor.
+//
+// Returns:
+//
+//	The updated es.weightedTokensType object with the "boost" field set.
+func (wt weightedTokensType) Boost(boost float64) weightedTokensType {
+	return wt.putInTheField("boost", boost)
+}
+
+func (wt weightedTokensType) putInTheField(key string, value any) weightedTokensType {
+	return genericPutInTheFieldOfFirstChild(wt, "weighted_tokens", key, value)
+}
@@ -0,0 +1,42 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/test/assert"
+)
+
+////   WeightedTokens   ////
+
+func Test_WeightedTokens_should_exist_on_es_package(t *testing.T) {
+	t.Parallel()
+	// Given When Then
+	assert.NotNil(t, es.WeightedTokens)
+}
+
+func Test_WeightedTokens_should_create_weightedTokensType(t *testing.T) {
+	t.Parallel()
+	// Given When
+	weightedTokens := es.WeightedTokens("content_embedding", map[string]float64{"shoe": 1.2, "running": 0.8})
+
+	// Then
+	assert.IsTypeString(t, "es.weightedTokensType", weightedTokens)
+	bodyJSON := assert.MarshalWithoutError(t, weightedTokens)
+	assert.Equal(t, "{\"weighted_tokens\":{\"content_embedding\":{\"tokens\":{\"running\":0.8,\"shoe\":1.2}}}}", bodyJSON)
+}
+
+func Test_WeightedTokens_should_create_json_with_pruning_config_and_boost(t *testing.T) {
+	t.Parallel()
+	// Given
+	query := es.NewQuery(es.Bool().Should(
+		es.WeightedTokens("content_embedding", map[string]float64{"shoe": 1.2}).
+			PruningConfig(es.PruningConfig().OnlyScorePrunedTokens(true)).
+			Boost(0.5),
+	))
+
+	// When Then
+	bodyJSON := assert.MarshalWithoutError(t, query)
+	// nolint:golint,lll
+	assert.Equal(t, "{\"query\":{\"bool\":{\"should\":[{\"weighted_tokens\":{\"content_embedding\":{\"boost\":0.5,\"pruning_config\":{\"only_score_pruned_tokens\":true},\"tokens\":{\"shoe\":1.2}}}}]}}}", bodyJSON)
+}
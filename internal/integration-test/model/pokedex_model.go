@@ -2,7 +2,9 @@ package model
 
 import (
 	"fmt"
+
 	"github.com/Trendyol/es-query-builder/es"
+	"github.com/Trendyol/es-query-builder/es/mapping"
 )
 
 type Pokemons []Pokemon
@@ -67,135 +69,55 @@ func (poke *Pokemon) Copy() Pokemon {
 }
 
 func (poke *Pokemon) GetMappings() es.Object {
-	return es.Object{
-		"properties": es.Object{
-			"name": es.Object{
-				"type":            "text",
-				"analyzer":        "pokemon_name_analyzer",
-				"search_analyzer": "standard",
-				"fields": es.Object{
-					"keyword": es.Object{
-						"type":         "keyword",
-						"ignore_above": 256,
-					},
-				},
-			},
-			"abilities": es.Object{
-				"type": "nested",
-				"properties": es.Object{
-					"name": es.Object{
-						"type": "keyword",
-					},
-					"slot": es.Object{
-						"type": "short",
-					},
-					"isHidden": es.Object{
-						"type": "boolean",
-					},
-				},
-			},
-			"moves": es.Object{
-				"type": "nested",
-				"properties": es.Object{
-					"name": es.Object{
-						"type": "keyword",
-					},
-					"versionGroupDetails": es.Object{
-						"type": "nested",
-						"properties": es.Object{
-							"moveLearnMethodName": es.Object{
-								"type": "keyword",
-							},
-							"versionGroupName": es.Object{
-								"type": "keyword",
-							},
-							"levelLearnedAt": es.Object{
-								"type": "short",
-							},
-						},
-					},
-				},
-			},
-			"types": es.Object{
-				"type": "nested",
-				"properties": es.Object{
-					"name": es.Object{
-						"type": "keyword",
-					},
-					"slot": es.Object{
-						"type": "short",
-					},
-				},
-			},
-			"stats": es.Object{
-				"type": "nested",
-				"properties": es.Object{
-					"name": es.Object{
-						"type": "keyword",
-					},
-					"baseStat": es.Object{
-						"type": "short",
-					},
-					"effort": es.Object{
-						"type": "short",
-					},
-				},
-			},
-			"id": es.Object{
-				"type": "short",
-			},
-			"height": es.Object{
-				"type": "short",
-			},
-			"weight": es.Object{
-				"type": "short",
-			},
-			"baseExperience": es.Object{
-				"type": "short",
-			},
-			"order": es.Object{
-				"type": "short",
-			},
-			"isDefault": es.Object{
-				"type": "boolean",
-			},
-		},
-	}
+	return es.Object(mapping.Mappings().Properties(
+		mapping.Property("name", mapping.Text().
+			Analyzer("pokemon_name_analyzer").
+			SearchAnalyzer("standard").
+			Fields(mapping.Property("keyword", mapping.Keyword().IgnoreAbove(256))),
+		),
+		mapping.Property("abilities", mapping.Nested().Properties(
+			mapping.Property("name", mapping.Keyword()),
+			mapping.Property("slot", mapping.Short()),
+			mapping.Property("isHidden", mapping.Boolean()),
+		)),
+		mapping.Property("moves", mapping.Nested().Properties(
+			mapping.Property("name", mapping.Keyword()),
+			mapping.Property("versionGroupDetails", mapping.Nested().Properties(
+				mapping.Property("moveLearnMethodName", mapping.Keyword()),
+				mapping.Property("versionGroupName", mapping.Keyword()),
+				mapping.Property("levelLearnedAt", mapping.Short()),
+			)),
+		)),
+		mapping.Property("types", mapping.Nested().Properties(
+			mapping.Property("name", mapping.Keyword()),
+			mapping.Property("slot", mapping.Short()),
+		)),
+		mapping.Property("stats", mapping.Nested().Properties(
+			mapping.Property("name", mapping.Keyword()),
+			mapping.Property("baseStat", mapping.Short()),
+			mapping.Property("effort", mapping.Short()),
+		)),
+		mapping.Property("id", mapping.Short()),
+		mapping.Property("height", mapping.Short()),
+		mapping.Property("weight", mapping.Short()),
+		mapping.Property("baseExperience", mapping.Short()),
+		mapping.Property("order", mapping.Short()),
+		mapping.Property("isDefault", mapping.Boolean()),
+	))
 }
 
 func (poke *Pokemon) GetSettings() es.Object {
-	return es.Object{
-		"index": es.Object{
-			"refresh_interval":   "1s",
-			"number_of_shards":   1,
-			"number_of_replicas": 1,
-			"max_result_window":  10_000,
-			"max_terms_count":    1024,
-		},
-		"analysis": es.Object{
-			"analyzer": es.Object{
-				"pokemon_name_analyzer": es.Object{
-					"type":      "custom",
-					"tokenizer": "pokemon_name_tokenizer",
-					"filter": es.Array{
-						"lowercase",
-						"asciifolding",
-					},
-				},
-			},
-			"tokenizer": es.Object{
-				"pokemon_name_tokenizer": es.Object{
-					"type":     "edge_ngram",
-					"min_gram": 2,
-					"max_gram": 20,
-					"token_chars": es.Array{
-						"letter",
-						"digit",
-					},
-				},
-			},
-		},
-	}
+	return es.Object(mapping.Settings().
+		RefreshInterval("1s").
+		NumberOfShards(1).
+		NumberOfReplicas(1).
+		MaxResultWindow(10_000).
+		MaxTermsCount(1024).
+		Analysis(mapping.Analysis().
+			Analyzer("pokemon_name_analyzer", mapping.CustomAnalyzer("pokemon_name_tokenizer").Filter("lowercase", "asciifolding")).
+			Tokenizer("pokemon_name_tokenizer", mapping.EdgeNGramTokenizer(2, 20).TokenChars("letter", "digit")),
+		),
+	)
 }
 
 type Ability struct {